@@ -0,0 +1,97 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/congress"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var commandEpoch = cli.Command{
+	Name:  "epoch",
+	Usage: "Fetches the congress epoch block trusted by light clients for a checkpoint",
+	Flags: []cli.Flag{
+		nodeURLFlag,
+		indexFlag,
+	},
+	Action: utils.MigrateFlags(epoch),
+}
+
+// epoch fetches the latest epoch block covered by the specified checkpoint or the
+// latest one, which congress light clients trust the validator set of.
+func epoch(ctx *cli.Context) error {
+	var (
+		client     = newRPCClient(ctx.GlobalString(nodeURLFlag.Name))
+		checkpoint = getCheckpoint(ctx, client)
+		genesis    congress.Params
+	)
+	if err := client.Call(&genesis, "congress_getParams", hexutil.Uint64(0)); err != nil {
+		utils.Fatalf("Failed to get consensus parameters %v, please ensure the congress API is exposed", err)
+	}
+	// The headers are verified by the node, the consensus parameters of its epoch
+	// blocks are empty before the governance fork
+	engine := congress.New(&params.ChainConfig{Congress: &params.CongressConfig{Period: genesis.Period, Epoch: genesis.Epoch, GovernanceBlock: common.Big0}}, nil)
+
+	eth := ethclient.NewClient(client)
+	latest := (checkpoint.SectionIndex+1)*params.CHTFrequency - 1
+	head, err := eth.HeaderByNumber(context.Background(), new(big.Int).SetUint64(latest))
+	if err != nil {
+		return err
+	}
+	if head.Hash() != checkpoint.SectionHead {
+		utils.Fatalf("Checkpoint section head mismatch: have %s, want %s", head.Hash().Hex(), checkpoint.SectionHead.Hex())
+	}
+	// Start from the epoch length in effect at the checkpoint
+	var current congress.Params
+	if err := client.Call(&current, "congress_getParams", hexutil.Uint64(latest)); err != nil {
+		return err
+	}
+	header, err := engine.LatestEpoch(latest, current.Epoch, func(number uint64) (*types.Header, error) {
+		return eth.HeaderByNumber(context.Background(), new(big.Int).SetUint64(number))
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Checkpoint %d => %s\n", checkpoint.SectionIndex, checkpoint.Hash().Hex())
+	fmt.Printf("Epoch #%d => %s\n", header.Number, header.Hash().Hex())
+	fmt.Println()
+
+	var p congress.Params
+	if err := client.Call(&p, "congress_getParams", hexutil.Uint64(header.Number.Uint64())); err != nil {
+		return err
+	}
+	fmt.Printf("Consensus parameters => period %ds, epoch %d, max validators %d\n", p.Period, p.Epoch, p.MaxValidators)
+
+	var validators []string
+	if err := client.Call(&validators, "congress_getValidatorsAtHash", header.Hash()); err != nil {
+		return err
+	}
+	for i, validator := range validators {
+		fmt.Printf("Validator %d => %s\n", i+1, validator)
+	}
+	return nil
+}
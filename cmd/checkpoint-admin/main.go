@@ -43,6 +43,7 @@ func init() {
 		commandDeploy,
 		commandSign,
 		commandPublish,
+		commandEpoch,
 	}
 	app.Flags = []cli.Flag{
 		oracleFlag,
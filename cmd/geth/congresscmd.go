@@ -0,0 +1,566 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	congressFromFlag = cli.Uint64Flag{
+		Name:  "from",
+		Usage: "First block number of the range (inclusive)",
+	}
+	congressToFlag = cli.Uint64Flag{
+		Name:  "to",
+		Usage: "Last block number of the range (inclusive), defaults to the chain head",
+		Value: math.MaxUint64,
+	}
+	congressAddressFlag = cli.StringFlag{
+		Name:  "address",
+		Usage: "Only export the changes of the given address",
+	}
+	congressFormatFlag = cli.StringFlag{
+		Name:  "format",
+		Usage: "Output format (json, csv)",
+		Value: "json",
+	}
+	congressBeforeFlag = cli.Uint64Flag{
+		Name:  "before",
+		Usage: "Also prune the snapshots of canonical blocks below the given number",
+	}
+	congressThreadsFlag = cli.IntFlag{
+		Name:  "threads",
+		Usage: "Number of headers ranges verified in parallel",
+		Value: runtime.NumCPU(),
+	}
+)
+
+// congressChainFlags are the flags needed to open the chain database.
+var congressChainFlags = []cli.Flag{
+	utils.DataDirFlag,
+	utils.AncientFlag,
+	utils.SyncModeFlag,
+	utils.MainnetFlag,
+	utils.TestnetFlag,
+}
+
+var (
+	congressCommand = cli.Command{
+		Name:        "congress",
+		Usage:       "A set of commands for the congress consensus engine",
+		Category:    "MISCELLANEOUS COMMANDS",
+		Description: "",
+		Subcommands: []cli.Command{
+			{
+				Name:      "policy-log",
+				Usage:     "Export the history of blacklist, whitelist and event check rule changes",
+				ArgsUsage: "[<filename>]",
+				Action:    utils.MigrateFlags(exportPolicyLog),
+				Category:  "MISCELLANEOUS COMMANDS",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.AncientFlag,
+					utils.SyncModeFlag,
+					utils.MainnetFlag,
+					utils.TestnetFlag,
+					congressFromFlag,
+					congressToFlag,
+					congressAddressFlag,
+					congressFormatFlag,
+				},
+				Description: `
+geth congress policy-log [--from <n>] [--to <n>] [--address <addr>] [--format json|csv] [<filename>]
+exports every change of the blacklist, the developer and user whitelists, the
+event check rules and the list admins recorded by the policy indexer, together
+with its block, transaction and admin. The output is written to stdout if no
+filename is given.
+
+Note, only the changes of already indexed sections are exported, the latest
+blocks are indexed after being confirmed.
+`,
+			},
+			{
+				Name:     "snapshot",
+				Usage:    "Manage the stored validator snapshots",
+				Category: "MISCELLANEOUS COMMANDS",
+				Subcommands: []cli.Command{
+					{
+						Name:   "list",
+						Usage:  "List the stored validator snapshots",
+						Action: utils.MigrateFlags(listSnapshots),
+						Flags:  congressChainFlags,
+						Description: `
+geth congress snapshot list
+lists every validator snapshot stored in the database with its block and
+validator count, flagging the ones which are not on the canonical chain.
+`,
+					},
+					{
+						Name:      "show",
+						Usage:     "Show a stored validator snapshot",
+						ArgsUsage: "<hash|number>",
+						Action:    utils.MigrateFlags(showSnapshot),
+						Flags:     congressChainFlags,
+						Description: `
+geth congress snapshot show <hash|number>
+prints the validator snapshot stored for the given block, a number is resolved
+to the canonical block.
+`,
+					},
+					{
+						Name:   "rebuild",
+						Usage:  "Re-derive the validator snapshots from the headers",
+						Action: utils.MigrateFlags(rebuildSnapshots),
+						Flags:  append([]cli.Flag{congressFromFlag, congressToFlag}, congressChainFlags...),
+						Description: `
+geth congress snapshot rebuild [--from <n>] [--to <n>]
+re-derives the validator snapshots of the canonical chain and stores them at
+every checkpoint, overwriting the stored ones. The derivation starts from the
+genesis validators, or from the stored snapshot of the --from block, which must
+be a checkpoint (every 1024 blocks).
+`,
+					},
+					{
+						Name:   "prune",
+						Usage:  "Delete the stale validator snapshots",
+						Action: utils.MigrateFlags(pruneSnapshots),
+						Flags:  append([]cli.Flag{congressBeforeFlag}, congressChainFlags...),
+						Description: `
+geth congress snapshot prune [--before <n>]
+deletes the validator snapshots of the blocks which are not on the canonical
+chain, and with --before, the ones of the canonical blocks below the given
+number too. Pruned snapshots are re-derived from the headers when needed.
+`,
+					},
+				},
+			},
+			{
+				Name:   "verify-epochs",
+				Usage:  "Verify the validators of the epoch headers against the state",
+				Action: utils.MigrateFlags(verifyEpochs),
+				Flags:  append([]cli.Flag{congressFromFlag, congressToFlag}, congressChainFlags...),
+				Description: `
+geth congress verify-epochs [--from <n>] [--to <n>]
+re-derives the validators chosen at every epoch block of the range from the
+state of its parent, and compares them with the ones in the header extra data.
+Epochs whose parent state is not available (pruned) are skipped.
+`,
+			},
+			{
+				Name:   "verify-seals",
+				Usage:  "Verify the signatures and difficulties of the headers",
+				Action: utils.MigrateFlags(verifySeals),
+				Flags:  append([]cli.Flag{congressFromFlag, congressToFlag, congressThreadsFlag}, congressChainFlags...),
+				Description: `
+geth congress verify-seals [--from <n>] [--to <n>] [--threads <n>]
+checks the signer, the recent signing restriction and the difficulty of every
+header of the range against the validator snapshot of its parent, verifying
+ranges of headers in parallel.
+`,
+			},
+			congressValidatorCommand,
+		},
+	}
+)
+
+// policyLogHeader is the header line of the csv policy log export.
+var policyLogHeader = []string{"blockNumber", "blockHash", "transactionHash", "transactionIndex", "logIndex", "contract", "event", "admin", "address", "direction", "eventSig", "ruleLocation", "ruleIndex", "checkType", "enabled"}
+
+func exportPolicyLog(ctx *cli.Context) error {
+	if ctx.NArg() > 1 {
+		return fmt.Errorf("too many arguments: %v", ctx.Command.ArgsUsage)
+	}
+	format := ctx.String(congressFormatFlag.Name)
+	if format != "json" && format != "csv" {
+		return fmt.Errorf("unsupported format %q", format)
+	}
+	var filter *common.Address
+	if ctx.IsSet(congressAddressFlag.Name) {
+		hex := ctx.String(congressAddressFlag.Name)
+		if !common.IsHexAddress(hex) {
+			return fmt.Errorf("invalid address %q", hex)
+		}
+		addr := common.HexToAddress(hex)
+		filter = &addr
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, true)
+	defer db.Close()
+
+	var out io.Writer = os.Stdout
+	if ctx.NArg() == 1 {
+		fh, err := os.Create(ctx.Args().First())
+		if err != nil {
+			return err
+		}
+		defer fh.Close()
+		out = fh
+	}
+	var (
+		write func(*congress.PolicyChange) error
+		flush = func() error { return nil }
+	)
+	switch format {
+	case "json":
+		enc := json.NewEncoder(out)
+		write = func(change *congress.PolicyChange) error { return enc.Encode(change) }
+	case "csv":
+		w := csv.NewWriter(out)
+		if err := w.Write(policyLogHeader); err != nil {
+			return err
+		}
+		write = func(change *congress.PolicyChange) error { return w.Write(policyLogRecord(change)) }
+		flush = func() error { w.Flush(); return w.Error() }
+	}
+	var (
+		count    int
+		writeErr error
+	)
+	err := congress.IteratePolicyChanges(db, ctx.Uint64(congressFromFlag.Name), ctx.Uint64(congressToFlag.Name), func(change *congress.PolicyChange) bool {
+		if filter != nil && (change.Address == nil || *change.Address != *filter) {
+			return true
+		}
+		if writeErr = write(change); writeErr != nil {
+			return false
+		}
+		count++
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if writeErr != nil {
+		return writeErr
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Exported %d policy changes\n", count)
+	return nil
+}
+
+// policyLogRecord flattens a policy change into a csv record.
+func policyLogRecord(change *congress.PolicyChange) []string {
+	record := []string{
+		strconv.FormatUint(change.BlockNumber, 10),
+		change.BlockHash.Hex(),
+		change.TxHash.Hex(),
+		strconv.FormatUint(uint64(change.TxIndex), 10),
+		strconv.FormatUint(uint64(change.LogIndex), 10),
+		change.Contract.Hex(),
+		change.Event,
+		change.Admin.Hex(),
+		"", change.Direction, "", change.RuleLocation, "", change.CheckType, "",
+	}
+	if change.Address != nil {
+		record[8] = change.Address.Hex()
+	}
+	if change.EventSig != nil {
+		record[10] = change.EventSig.Hex()
+	}
+	if change.RuleIndex != nil {
+		record[12] = strconv.FormatUint(*change.RuleIndex, 10)
+	}
+	if change.Enabled != nil {
+		record[14] = strconv.FormatBool(*change.Enabled)
+	}
+	return record
+}
+
+func listSnapshots(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, true)
+	defer db.Close()
+
+	var snaps []*congress.Snapshot
+	if err := congress.IterateSnapshots(db, func(snap *congress.Snapshot) bool {
+		snaps = append(snaps, snap)
+		return true
+	}); err != nil {
+		return err
+	}
+	sort.Slice(snaps, func(i, j int) bool { return snaps[i].Number < snaps[j].Number })
+	for _, snap := range snaps {
+		stale := ""
+		if rawdb.ReadCanonicalHash(db, snap.Number) != snap.Hash {
+			stale = " (stale)"
+		}
+		fmt.Printf("#%d %s validators=%d recents=%d%s\n", snap.Number, snap.Hash.Hex(), len(snap.Validators), len(snap.Recents), stale)
+	}
+	fmt.Printf("%d snapshots\n", len(snaps))
+	return nil
+}
+
+func showSnapshot(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return fmt.Errorf("expected 1 argument (number or hash), got %d", ctx.NArg())
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, true)
+	defer db.Close()
+
+	var (
+		arg  = ctx.Args().First()
+		hash common.Hash
+	)
+	if hashish(arg) {
+		hash = common.HexToHash(arg)
+	} else {
+		number, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return err
+		}
+		if hash = rawdb.ReadCanonicalHash(db, number); hash == (common.Hash{}) {
+			return fmt.Errorf("block %d not found", number)
+		}
+	}
+	snap, err := congress.ReadSnapshot(db, hash)
+	if err != nil {
+		return fmt.Errorf("no snapshot stored for block %x: %v", hash, err)
+	}
+	out, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+func rebuildSnapshots(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chain, db := utils.MakeChain(ctx, stack)
+	defer db.Close()
+	defer chain.Stop()
+
+	engine, ok := chain.Engine().(*congress.Congress)
+	if !ok {
+		return errors.New("not a congress chain")
+	}
+	from, to := congressRange(ctx, chain)
+	start := time.Now()
+	stored, err := engine.RebuildSnapshots(chain, from, to)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Rebuilt %d snapshots up to block %d, elapsed %s\n", stored, to, common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+func pruneSnapshots(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	db := utils.MakeChainDatabase(ctx, stack, false)
+	defer db.Close()
+
+	before := ctx.Uint64(congressBeforeFlag.Name)
+	var stale []common.Hash
+	if err := congress.IterateSnapshots(db, func(snap *congress.Snapshot) bool {
+		if snap.Number < before || rawdb.ReadCanonicalHash(db, snap.Number) != snap.Hash {
+			stale = append(stale, snap.Hash)
+		}
+		return true
+	}); err != nil {
+		return err
+	}
+	batch := db.NewBatch()
+	for _, hash := range stale {
+		if err := congress.DeleteSnapshot(batch, hash); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	fmt.Printf("Pruned %d snapshots\n", len(stale))
+	return nil
+}
+
+func verifyEpochs(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chain, db := utils.MakeChain(ctx, stack)
+	defer db.Close()
+	defer chain.Stop()
+
+	engine, ok := chain.Engine().(*congress.Congress)
+	if !ok {
+		return errors.New("not a congress chain")
+	}
+	engine.SetStateFn(chain.StateAt)
+
+	var (
+		from, to   = congressRange(ctx, chain)
+		checked    int
+		skipped    int
+		mismatches int
+		logged     = time.Now()
+	)
+	err := engine.IterateEpochs(chain, from, to, func(header *types.Header) bool {
+		number := header.Number.Uint64()
+		switch err := engine.VerifyEpochValidators(chain, header); {
+		case errors.Is(err, congress.ErrEpochStateMissing):
+			skipped++
+		case err != nil:
+			mismatches++
+			log.Error("Epoch validators mismatch", "number", number, "hash", header.Hash(), "err", err)
+		default:
+			checked++
+		}
+		if time.Since(logged) > 8*time.Second {
+			log.Info("Verifying epochs", "number", number, "checked", checked, "skipped", skipped, "mismatches", mismatches)
+			logged = time.Now()
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Verified %d epochs, skipped %d without state, %d mismatches\n", checked, skipped, mismatches)
+	if mismatches > 0 {
+		return fmt.Errorf("%d epochs with mismatching validators", mismatches)
+	}
+	return nil
+}
+
+// sealFailure is a header failing the seal verification.
+type sealFailure struct {
+	number uint64
+	err    error
+}
+
+func verifySeals(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chain, db := utils.MakeChain(ctx, stack)
+	defer db.Close()
+	defer chain.Stop()
+
+	engine, ok := chain.Engine().(*congress.Congress)
+	if !ok {
+		return errors.New("not a congress chain")
+	}
+	from, to := congressRange(ctx, chain)
+	if from == 0 {
+		from = 1 // the genesis has no seal
+	}
+	threads := ctx.Int(congressThreadsFlag.Name)
+	if threads < 1 {
+		threads = 1
+	}
+	// Split the range at the stored snapshots and verify the ranges in parallel.
+	// A worker verifies the headers of its range in order, so every header is
+	// verified against the snapshot derived by the previous one. Only the first
+	// header of a range looks back to the last stored snapshot.
+	var (
+		ranges   = make(chan [2]uint64)
+		lock     sync.Mutex
+		failures []sealFailure
+		verified uint64
+		wg       sync.WaitGroup
+		start    = time.Now()
+		logged   = start
+	)
+	for i := 0; i < threads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range ranges {
+				for number := r[0]; number <= r[1]; number++ {
+					header := chain.GetHeaderByNumber(number)
+					err := errors.New("missing header")
+					if header != nil {
+						err = engine.VerifySeal(chain, header)
+					}
+					if err != nil {
+						lock.Lock()
+						failures = append(failures, sealFailure{number, err})
+						lock.Unlock()
+					}
+				}
+				lock.Lock()
+				verified += r[1] - r[0] + 1
+				if time.Since(logged) > 8*time.Second {
+					log.Info("Verifying seals", "verified", verified, "invalid", len(failures), "elapsed", common.PrettyDuration(time.Since(start)))
+					logged = time.Now()
+				}
+				lock.Unlock()
+			}
+		}()
+	}
+	for first := from; first <= to; {
+		last := (first/congress.CheckpointInterval + 1) * congress.CheckpointInterval
+		if last > to {
+			last = to
+		}
+		ranges <- [2]uint64{first, last}
+		first = last + 1
+	}
+	close(ranges)
+	wg.Wait()
+
+	sort.Slice(failures, func(i, j int) bool { return failures[i].number < failures[j].number })
+	for _, failure := range failures {
+		log.Error("Invalid seal", "number", failure.number, "err", failure.err)
+	}
+	fmt.Printf("Verified %d headers, %d invalid, elapsed %s\n", verified, len(failures), common.PrettyDuration(time.Since(start)))
+	if len(failures) > 0 {
+		return fmt.Errorf("%d headers with invalid seals", len(failures))
+	}
+	return nil
+}
+
+// congressRange returns the block range given by the from and to flags, capped
+// at the head of the chain.
+func congressRange(ctx *cli.Context, chain *core.BlockChain) (uint64, uint64) {
+	from, to := ctx.Uint64(congressFromFlag.Name), ctx.Uint64(congressToFlag.Name)
+	if head := chain.CurrentHeader().Number.Uint64(); to > head {
+		to = head
+	}
+	return from, to
+}
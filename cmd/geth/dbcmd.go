@@ -18,6 +18,8 @@ package main
 
 import (
 	"bytes"
+	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -32,10 +34,14 @@ import (
 	"github.com/ethereum/go-ethereum/cmd/utils"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/congress"
 	"github.com/ethereum/go-ethereum/console/prompt"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/trie"
 	"gopkg.in/urfave/cli.v1"
 )
@@ -123,7 +129,9 @@ corruption if it is aborted during execution'!`,
 			utils.MainnetFlag,
 			utils.TestnetFlag,
 		},
-		Description: "This command looks up the specified database key from the database.",
+		Description: `This command looks up the specified database key from the database.
+Headers, bodies and validator snapshots are also decoded, showing the congress
+extra data, signer and governance transactions.`,
 	}
 	dbDeleteCmd = cli.Command{
 		Action:    utils.MigrateFlags(dbDelete),
@@ -363,6 +371,58 @@ func dbGet(ctx *cli.Context) error {
 		return err
 	}
 	fmt.Printf("key %#x: %#x\n", key, data)
+	return dumpCongressValue(db, key, data)
+}
+
+// dumpCongressValue prints the congress view of headers, block bodies and
+// validator snapshots. Other values are left alone.
+func dumpCongressValue(db ethdb.Database, key, data []byte) error {
+	chainConfig := rawdb.ReadChainConfig(db, rawdb.ReadCanonicalHash(db, 0))
+	if chainConfig == nil || chainConfig.Congress == nil {
+		chainConfig = params.MainnetChainConfig
+	}
+	var value interface{}
+	switch {
+	case len(key) == 1+8+common.HashLength && key[0] == 'h':
+		header := new(types.Header)
+		if err := rlp.DecodeBytes(data, header); err != nil {
+			return nil
+		}
+		value = congress.DumpHeader(header, chainConfig.Congress)
+
+	case len(key) == 1+8+common.HashLength && key[0] == 'b':
+		body := new(types.Body)
+		if err := rlp.DecodeBytes(data, body); err != nil {
+			return nil
+		}
+		// The system-transactions are told apart by the coinbase of the block
+		number, hash := binary.BigEndian.Uint64(key[1:9]), common.BytesToHash(key[9:])
+		header := rawdb.ReadHeader(db, hash, number)
+		if header == nil {
+			return nil
+		}
+		signer := types.MakeSigner(chainConfig, header.Number)
+		props := congress.DumpBlockProposals(signer, header, body.Transactions)
+		if len(props) == 0 {
+			return nil
+		}
+		value = props
+
+	case congress.IsSnapshotKey(key):
+		snap := new(congress.Snapshot)
+		if err := json.Unmarshal(data, snap); err != nil {
+			return err
+		}
+		value = snap
+
+	default:
+		return nil
+	}
+	out, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
 	return nil
 }
 
@@ -0,0 +1,528 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/consensus/congress"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract/bindings"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/congressclient"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	validatorRPCFlag = cli.StringFlag{
+		Name:  "rpc",
+		Usage: "Endpoint of the node to talk to, defaults to the IPC endpoint of the default datadir",
+	}
+	validatorVanityFlag = cli.StringFlag{
+		Name:  "vanity",
+		Usage: "Hex encoded vanity prefix of the extra data (up to 32 bytes)",
+	}
+	validatorKeyFileFlag = cli.StringFlag{
+		Name:  "keyfile",
+		Usage: "Key file of the account signing the transaction (the contract admin to register, the manager to stake)",
+	}
+	validatorManagerFlag = cli.StringFlag{
+		Name:  "manager",
+		Usage: "Manager of the validator vote pool, defaults to the validator",
+	}
+	validatorPercentFlag = cli.Uint64Flag{
+		Name:  "percent",
+		Usage: "Share of the rewards kept by the validator, in the units of the validators contract",
+	}
+	validatorTypeFlag = cli.StringFlag{
+		Name:  "type",
+		Usage: "Validator type (pos, poa)",
+		Value: "pos",
+	}
+	validatorAmountFlag = cli.StringFlag{
+		Name:  "amount",
+		Usage: "Margin to deposit in wei, defaults to the minimal margin of the validator type",
+	}
+)
+
+// validatorTypes are the validator types of the validators contract, by name.
+var validatorTypes = map[string]uint8{
+	"pos": 0,
+	"poa": 1,
+}
+
+// votePoolStates are the names of the states of a vote pool.
+var votePoolStates = map[uint8]string{
+	systemcontract.VotePoolIdle:  "idle (not enough margin)",
+	systemcontract.VotePoolReady: "ready",
+	systemcontract.VotePoolPause: "paused",
+	systemcontract.VotePoolJail:  "jailed",
+}
+
+var congressValidatorCommand = cli.Command{
+	Name:     "validator",
+	Usage:    "Bring up and inspect validators",
+	Category: "MISCELLANEOUS COMMANDS",
+	Subcommands: []cli.Command{
+		{
+			Name:   "keygen",
+			Usage:  "Create the key of a new validator",
+			Action: utils.MigrateFlags(validatorKeygen),
+			Flags: []cli.Flag{
+				utils.DataDirFlag,
+				utils.KeyStoreDirFlag,
+				utils.PasswordFileFlag,
+				utils.LightKDFFlag,
+			},
+			Description: `
+geth congress validator keygen
+creates a new key in the keystore, to be used as the validator and etherbase of
+a sealing node, and prints the flags to start sealing with it.
+`,
+		},
+		{
+			Name:      "genesis-extra",
+			Usage:     "Build the genesis extra data out of the initial validators",
+			ArgsUsage: "<address> [<address>...]",
+			Action:    utils.MigrateFlags(validatorGenesisExtra),
+			Flags:     []cli.Flag{validatorVanityFlag},
+			Description: `
+geth congress validator genesis-extra [--vanity <hex>] <address> [<address>...]
+prints the extraData field of a congress genesis for the given validators: the
+32 byte vanity, the validators in ascending order and an empty 65 byte seal.
+`,
+		},
+		{
+			Name:      "register",
+			Usage:     "Register a validator in the validators contract",
+			ArgsUsage: "<address>",
+			Action:    utils.MigrateFlags(validatorRegister),
+			Flags: []cli.Flag{
+				validatorRPCFlag,
+				validatorKeyFileFlag,
+				utils.PasswordFileFlag,
+				validatorManagerFlag,
+				validatorPercentFlag,
+				validatorTypeFlag,
+			},
+			Description: `
+geth congress validator register --keyfile <file> [--manager <addr>] [--percent <n>] [--type pos|poa] <address>
+sends the addValidator transaction of the validators contract, signed by its
+admin, and waits for it to be mined. The contract creates the vote pool of the
+validator, which receives the margin and the votes of the stakers; the margin
+is deposited to the vote pool by the manager before the validator is ranked
+(geth congress validator stake).
+`,
+		},
+		{
+			Name:      "stake",
+			Usage:     "Deposit the margin of a validator into its vote pool",
+			ArgsUsage: "<address>",
+			Action:    utils.MigrateFlags(validatorStake),
+			Flags: []cli.Flag{
+				validatorRPCFlag,
+				validatorKeyFileFlag,
+				utils.PasswordFileFlag,
+				validatorAmountFlag,
+			},
+			Description: `
+geth congress validator stake --keyfile <file> [--amount <wei>] <address>
+sends the addMargin transaction of the vote pool of a registered validator,
+signed by its manager, and waits for it to be mined. Once the margin reaches
+the minimal margin of the validator type, the validator is ranked.
+`,
+		},
+		{
+			Name:      "status",
+			Usage:     "Show the stake, ranking, jail state and missed blocks of a validator",
+			ArgsUsage: "<address>",
+			Action:    utils.MigrateFlags(validatorStatus),
+			Flags:     []cli.Flag{validatorRPCFlag},
+			Description: `
+geth congress validator status <address>
+shows the vote pool, margin and stake of a validator, whether it seals blocks,
+whether the validators contract ranks it in the active or backup set (taking
+effect at the next epoch), the state of its vote pool with the block its jail
+ends at, and its missed blocks counter from the punish contract together with
+the thresholds at which it is punished and removed from the ranking.
+`,
+		},
+	},
+}
+
+func validatorKeygen(ctx *cli.Context) error {
+	cfg := gethConfig{Node: defaultNodeConfig()}
+	utils.SetNodeConfig(ctx, &cfg.Node)
+	keydir, err := cfg.Node.KeyDirConfig()
+	if err != nil {
+		return err
+	}
+	scryptN, scryptP := keystore.StandardScryptN, keystore.StandardScryptP
+	if cfg.Node.UseLightweightKDF {
+		scryptN, scryptP = keystore.LightScryptN, keystore.LightScryptP
+	}
+	password := utils.GetPassPhraseWithList("Your new validator key is locked with a password. Please give a password. Do not forget this password.", true, 0, utils.MakePasswordList(ctx))
+
+	account, err := keystore.StoreKey(keydir, password, scryptN, scryptP)
+	if err != nil {
+		return fmt.Errorf("failed to create key: %v", err)
+	}
+	fmt.Printf("\nYour new validator key was generated\n\n")
+	fmt.Printf("Validator address:           %s\n", account.Address.Hex())
+	fmt.Printf("Path of the secret key file: %s\n\n", account.URL.Path)
+	fmt.Printf("Seal blocks with the key by starting the node with:\n\n")
+	fmt.Printf("  --mine --miner.etherbase %s --unlock %s --password <file>\n\n", account.Address.Hex(), account.Address.Hex())
+	fmt.Printf("- Include the address in the genesis extra data (geth congress validator genesis-extra)\n")
+	fmt.Printf("  or register it in the validators contract (geth congress validator register).\n")
+	fmt.Printf("- You must BACKUP your key file and REMEMBER its password!\n\n")
+	return nil
+}
+
+func validatorGenesisExtra(ctx *cli.Context) error {
+	if ctx.NArg() == 0 {
+		return errors.New("no validators given")
+	}
+	validators := make([]common.Address, 0, ctx.NArg())
+	for _, arg := range ctx.Args() {
+		if !common.IsHexAddress(arg) {
+			return fmt.Errorf("invalid address %q", arg)
+		}
+		validators = append(validators, common.HexToAddress(arg))
+	}
+	var vanity []byte
+	if ctx.IsSet(validatorVanityFlag.Name) {
+		var err error
+		if vanity, err = hexutil.Decode(ctx.String(validatorVanityFlag.Name)); err != nil {
+			return fmt.Errorf("invalid vanity: %v", err)
+		}
+	}
+	extra, err := congress.EpochExtra(vanity, validators)
+	if err != nil {
+		return err
+	}
+	fmt.Println(hexutil.Encode(extra))
+	return nil
+}
+
+// validatorArg parses the validator address given as the single argument.
+func validatorArg(ctx *cli.Context) (common.Address, error) {
+	if ctx.NArg() != 1 {
+		return common.Address{}, fmt.Errorf("expected 1 argument (validator address), got %d", ctx.NArg())
+	}
+	if !common.IsHexAddress(ctx.Args().First()) {
+		return common.Address{}, fmt.Errorf("invalid address %q", ctx.Args().First())
+	}
+	return common.HexToAddress(ctx.Args().First()), nil
+}
+
+func validatorRegister(ctx *cli.Context) error {
+	validator, err := validatorArg(ctx)
+	if err != nil {
+		return err
+	}
+	manager := validator
+	if ctx.IsSet(validatorManagerFlag.Name) {
+		hex := ctx.String(validatorManagerFlag.Name)
+		if !common.IsHexAddress(hex) {
+			return fmt.Errorf("invalid manager %q", hex)
+		}
+		manager = common.HexToAddress(hex)
+	}
+	kind, ok := validatorTypes[strings.ToLower(ctx.String(validatorTypeFlag.Name))]
+	if !ok {
+		return fmt.Errorf("unknown validator type %q", ctx.String(validatorTypeFlag.Name))
+	}
+	client, opts, err := validatorTransactor(ctx, "admin")
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	contract, err := bindings.NewValidators(systemcontract.ValidatorsContractAddr, client)
+	if err != nil {
+		return err
+	}
+	tx, err := contract.AddValidator(opts, validator, manager, new(big.Int).SetUint64(ctx.Uint64(validatorPercentFlag.Name)), kind)
+	if err != nil {
+		return fmt.Errorf("failed to send the registration: %v", err)
+	}
+	fmt.Printf("Sent registration %s, waiting for it to be mined\n", tx.Hash().Hex())
+
+	receipt, err := bind.WaitMined(context.Background(), client, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("registration failed in block %d", receipt.BlockNumber)
+	}
+	for _, log := range receipt.Logs {
+		if added, err := contract.ParseAddValidator(*log); err == nil {
+			fmt.Printf("Registered validator %s in block %d, vote pool %s\n", added.Validator.Hex(), receipt.BlockNumber, added.VotePool.Hex())
+			return nil
+		}
+	}
+	return fmt.Errorf("registration mined in block %d without vote pool", receipt.BlockNumber)
+}
+
+// validatorTransactor dials the node and unlocks the key file signing the
+// transactions of the given role.
+func validatorTransactor(ctx *cli.Context, role string) (*ethclient.Client, *bind.TransactOpts, error) {
+	if !ctx.IsSet(validatorKeyFileFlag.Name) {
+		return nil, nil, fmt.Errorf("no %s key file given (--keyfile)", role)
+	}
+	keyjson, err := ioutil.ReadFile(ctx.String(validatorKeyFileFlag.Name))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read the key file: %v", err)
+	}
+	password := utils.GetPassPhraseWithList("", false, 0, utils.MakePasswordList(ctx))
+
+	rpcClient, err := dialRPC(ctx.String(validatorRPCFlag.Name))
+	if err != nil {
+		return nil, nil, err
+	}
+	client := ethclient.NewClient(rpcClient)
+
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		client.Close()
+		return nil, nil, err
+	}
+	opts, err := bind.NewTransactorWithChainID(strings.NewReader(string(keyjson)), password, chainID)
+	if err != nil {
+		client.Close()
+		return nil, nil, err
+	}
+	return client, opts, nil
+}
+
+func validatorStake(ctx *cli.Context) error {
+	validator, err := validatorArg(ctx)
+	if err != nil {
+		return err
+	}
+	client, opts, err := validatorTransactor(ctx, "manager")
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	validators, err := bindings.NewValidatorsCaller(systemcontract.ValidatorsContractAddr, client)
+	if err != nil {
+		return err
+	}
+	votePool, err := validators.VotePools(nil, validator)
+	if err != nil {
+		return err
+	}
+	if votePool == (common.Address{}) {
+		return fmt.Errorf("validator %s is not registered", validator.Hex())
+	}
+	pool, err := bindings.NewVotePool(votePool, client)
+	if err != nil {
+		return err
+	}
+	if manager, err := pool.Manager(nil); err != nil {
+		return err
+	} else if manager != opts.From {
+		return fmt.Errorf("key of %s given, the manager of the validator is %s", opts.From.Hex(), manager.Hex())
+	}
+	if ctx.IsSet(validatorAmountFlag.Name) {
+		amount, ok := math.ParseBig256(ctx.String(validatorAmountFlag.Name))
+		if !ok || amount.Sign() <= 0 {
+			return fmt.Errorf("invalid amount %q", ctx.String(validatorAmountFlag.Name))
+		}
+		opts.Value = amount
+	} else {
+		kind, err := pool.ValidatorType(nil)
+		if err != nil {
+			return err
+		}
+		if kind == validatorTypes["poa"] {
+			opts.Value, err = validators.PoaMinMargin(nil)
+		} else {
+			opts.Value, err = validators.PosMinMargin(nil)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	tx, err := pool.AddMargin(opts)
+	if err != nil {
+		return fmt.Errorf("failed to send the margin: %v", err)
+	}
+	fmt.Printf("Sent margin of %s wei in %s, waiting for it to be mined\n", opts.Value, tx.Hash().Hex())
+
+	receipt, err := bind.WaitMined(context.Background(), client, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("margin deposit failed in block %d", receipt.BlockNumber)
+	}
+	margin, err := pool.Margin(&bind.CallOpts{BlockNumber: receipt.BlockNumber})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Deposited margin into vote pool %s in block %d, margin now %s wei\n", votePool.Hex(), receipt.BlockNumber, margin)
+	return nil
+}
+
+func validatorStatus(ctx *cli.Context) error {
+	validator, err := validatorArg(ctx)
+	if err != nil {
+		return err
+	}
+	rpcClient, err := dialRPC(ctx.String(validatorRPCFlag.Name))
+	if err != nil {
+		return err
+	}
+	client := ethclient.NewClient(rpcClient)
+	defer client.Close()
+
+	// Read everything at the same block to get a consistent view
+	header, err := client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+	opts := &bind.CallOpts{BlockNumber: header.Number}
+
+	validators, err := bindings.NewValidatorsCaller(systemcontract.ValidatorsContractAddr, client)
+	if err != nil {
+		return err
+	}
+	punish, err := bindings.NewPunishCaller(systemcontract.PunishContractAddr, client)
+	if err != nil {
+		return err
+	}
+	votePool, err := validators.VotePools(opts, validator)
+	if err != nil {
+		return err
+	}
+	if votePool == (common.Address{}) {
+		return fmt.Errorf("validator %s is not registered", validator.Hex())
+	}
+	stake, err := client.BalanceAt(context.Background(), votePool, header.Number)
+	if err != nil {
+		return err
+	}
+	reward, err := validators.PendingReward(opts, votePool)
+	if err != nil {
+		return err
+	}
+	pool, err := bindings.NewVotePoolCaller(votePool, client)
+	if err != nil {
+		return err
+	}
+	margin, err := pool.Margin(opts)
+	if err != nil {
+		return err
+	}
+	state, err := pool.State(opts)
+	if err != nil {
+		return err
+	}
+	punishBlock, err := pool.PunishBlk(opts)
+	if err != nil {
+		return err
+	}
+	active, err := validators.GetActiveValidators(opts)
+	if err != nil {
+		return err
+	}
+	backups, err := validators.GetBackupValidators(opts)
+	if err != nil {
+		return err
+	}
+	jailPeriod, err := validators.JailPeriod(opts)
+	if err != nil {
+		return err
+	}
+	missed, err := punish.GetPunishRecord(opts, validator)
+	if err != nil {
+		return err
+	}
+	punishThreshold, err := punish.PunishThreshold(opts)
+	if err != nil {
+		return err
+	}
+	removeThreshold, err := punish.RemoveThreshold(opts)
+	if err != nil {
+		return err
+	}
+	// The sealing set is the one of the consensus, it differs from the ranking
+	// of the contract until the next epoch
+	sealing := "no"
+	if set, err := congressclient.New(rpcClient).GetValidators(context.Background(), header.Number); err != nil {
+		sealing = fmt.Sprintf("unknown (%v)", err)
+	} else if containsAddress(set, validator) {
+		sealing = "yes"
+	}
+	ranking := "unranked"
+	switch {
+	case containsAddress(active, validator):
+		ranking = "active"
+	case containsAddress(backups, validator):
+		ranking = "backup"
+	}
+	status, ok := votePoolStates[state]
+	if !ok {
+		status = fmt.Sprintf("unknown (%d)", state)
+	}
+	// The manager can only get a jailed validator ranked again once the jail
+	// period since its punishment is over
+	if state == systemcontract.VotePoolJail {
+		release := new(big.Int).Add(punishBlock, jailPeriod)
+		if release.Cmp(header.Number) > 0 {
+			status = fmt.Sprintf("jailed since block %s, until block %s", punishBlock, release)
+		} else {
+			status = fmt.Sprintf("jailed since block %s, releasable since block %s", punishBlock, release)
+		}
+	}
+	fmt.Printf("Validator:      %s\n", validator.Hex())
+	fmt.Printf("Block:          %d\n", header.Number)
+	fmt.Printf("Vote pool:      %s\n", votePool.Hex())
+	fmt.Printf("Margin:         %s wei\n", margin)
+	fmt.Printf("Stake:          %s wei\n", stake)
+	fmt.Printf("Pending reward: %s wei\n", reward)
+	fmt.Printf("Sealing:        %s\n", sealing)
+	fmt.Printf("Ranking:        %s\n", ranking)
+	fmt.Printf("State:          %s\n", status)
+	fmt.Printf("Missed blocks:  %s (punished at %s, removed from the ranking at %s)\n", missed, punishThreshold, removeThreshold)
+	fmt.Printf("Jail period:    %s\n", jailPeriod)
+	return nil
+}
+
+func containsAddress(addrs []common.Address, addr common.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
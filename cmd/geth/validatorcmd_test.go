@@ -0,0 +1,73 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidatorGenesisExtra(t *testing.T) {
+	geth := runGeth(t, "congress", "validator", "genesis-extra", "--vanity", "0x6869",
+		"0x000000000000000000000000000000000000000b", "0x000000000000000000000000000000000000000a")
+	defer geth.ExpectExit()
+	geth.Expect(`
+0x6869000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000a000000000000000000000000000000000000000b0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000
+`)
+}
+
+func TestValidatorKeygen(t *testing.T) {
+	geth := runGeth(t, "congress", "validator", "keygen", "--lightkdf")
+	defer geth.ExpectExit()
+	geth.Expect(`
+Your new validator key is locked with a password. Please give a password. Do not forget this password.
+!! Unsupported terminal, password will be echoed.
+Password: {{.InputLine "foobar"}}
+Repeat password: {{.InputLine "foobar"}}
+
+Your new validator key was generated
+`)
+	geth.ExpectRegexp(`
+Validator address:           0x[0-9a-fA-F]{40}
+Path of the secret key file: .*UTC--.+--[0-9a-f]{40}
+
+Seal blocks with the key by starting the node with:
+
+  --mine --miner.etherbase 0x[0-9a-fA-F]{40} --unlock 0x[0-9a-fA-F]{40} --password <file>
+
+- Include the address in the genesis extra data \(geth congress validator genesis-extra\)
+  or register it in the validators contract \(geth congress validator register\).
+- You must BACKUP your key file and REMEMBER its password!
+`)
+}
+
+func TestValidatorStakeArgs(t *testing.T) {
+	for _, tt := range []struct {
+		args []string
+		err  string
+	}{
+		{[]string{"0x000000000000000000000000000000000000000a"}, "no manager key file given (--keyfile)"},
+		{[]string{"--keyfile", "missing.json", "nonsense"}, `invalid address "nonsense"`},
+	} {
+		args := append([]string{"congress", "validator", "stake"}, tt.args...)
+		geth := runGeth(t, args...)
+		geth.WaitExit()
+		if !strings.Contains(geth.StderrText(), tt.err) {
+			t.Errorf("stake %v: stderr text does not contain %q", tt.args, tt.err)
+		}
+	}
+}
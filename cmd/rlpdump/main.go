@@ -22,30 +22,40 @@ import (
 	"bytes"
 	"container/list"
 	"encoding/hex"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
+	"math/big"
 	"os"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
 var (
-	hexMode     = flag.String("hex", "", "dump given hex data")
-	reverseMode = flag.Bool("reverse", false, "convert ASCII to rlp")
-	noASCII     = flag.Bool("noascii", false, "don't print ASCII strings readably")
-	single      = flag.Bool("single", false, "print only the first element, discard the rest")
+	hexMode      = flag.String("hex", "", "dump given hex data")
+	reverseMode  = flag.Bool("reverse", false, "convert ASCII to rlp")
+	noASCII      = flag.Bool("noascii", false, "don't print ASCII strings readably")
+	single       = flag.Bool("single", false, "print only the first element, discard the rest")
+	congressMode = flag.Bool("congress", false, "decode congress headers, blocks and governance transactions")
+	epoch        = flag.Uint64("epoch", params.MainnetChainConfig.Congress.Epoch, "congress epoch length used to check the validator lists")
+	governance   = flag.Int64("governance", -1, "congress governance fork block, from which the epoch length is governed (-1 = no fork)")
 )
 
 func init() {
 	flag.Usage = func() {
-		fmt.Fprintln(os.Stderr, "Usage:", os.Args[0], "[-noascii] [-hex <data>][-reverse] [filename]")
+		fmt.Fprintln(os.Stderr, "Usage:", os.Args[0], "[-noascii] [-congress [-epoch <n>] [-governance <n>]] [-hex <data>][-reverse] [filename]")
 		flag.PrintDefaults()
 		fmt.Fprintln(os.Stderr, `
 Dumps RLP data from the given file in readable form.
-If the filename is omitted, data is read from stdin.`)
+If the filename is omitted, data is read from stdin.
+In congress mode, headers and blocks are printed with their extra data split
+into vanity, validators and seal, and governance transactions are decoded.`)
 	}
 }
 
@@ -96,7 +106,13 @@ func main() {
 func rlpToText(r io.Reader, out io.Writer) error {
 	s := rlp.NewStream(r, 0)
 	for {
-		if err := dump(s, 0, out); err != nil {
+		var err error
+		if *congressMode {
+			err = dumpCongress(s, out)
+		} else {
+			err = dump(s, 0, out)
+		}
+		if err != nil {
 			if err != io.EOF {
 				return err
 			}
@@ -149,6 +165,70 @@ func dump(s *rlp.Stream, depth int, out io.Writer) error {
 	return nil
 }
 
+// congressConfig returns the congress configuration given by the flags.
+func congressConfig() *params.CongressConfig {
+	config := &params.CongressConfig{Epoch: *epoch}
+	if *governance >= 0 {
+		config.GovernanceBlock = big.NewInt(*governance)
+	}
+	return config
+}
+
+// dumpCongress prints the next value as a congress header, block or governance
+// transaction, falling back to the plain dump for anything else.
+func dumpCongress(s *rlp.Stream, out io.Writer) error {
+	raw, err := s.Raw()
+	if err != nil {
+		return err
+	}
+	var (
+		header = new(types.Header)
+		block  = new(types.Block)
+		tx     = new(types.Transaction)
+	)
+	switch {
+	case rlp.DecodeBytes(raw, header) == nil:
+		return printJSON(out, congress.DumpHeader(header, congressConfig()))
+
+	case rlp.DecodeBytes(raw, block) == nil:
+		if err := printJSON(out, congress.DumpHeader(block.Header(), congressConfig())); err != nil {
+			return err
+		}
+		for _, tx := range block.Transactions() {
+			prop, err := congress.DumpProposal(tx)
+			if err != nil {
+				return err
+			}
+			if prop != nil {
+				fmt.Fprintln(out)
+				if err := printJSON(out, prop); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+
+	case rlp.DecodeBytes(raw, tx) == nil:
+		prop, err := congress.DumpProposal(tx)
+		if err != nil {
+			return err
+		}
+		if prop != nil {
+			return printJSON(out, prop)
+		}
+	}
+	return dump(rlp.NewStream(bytes.NewReader(raw), 0), 0, out)
+}
+
+func printJSON(out io.Writer, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(data)
+	return err
+}
+
 func isASCII(b []byte) bool {
 	for _, c := range b {
 		if c < 32 || c > 126 {
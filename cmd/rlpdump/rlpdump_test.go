@@ -3,11 +3,17 @@ package main
 import (
 	"bytes"
 	"fmt"
+	"math/big"
 	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/congress"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 func TestRoundtrip(t *testing.T) {
@@ -63,3 +69,47 @@ func TestTextToRlp(t *testing.T) {
 		}
 	}
 }
+
+func TestCongressDump(t *testing.T) {
+	*congressMode, *epoch = true, 200
+	defer func() { *congressMode = false }()
+
+	key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	validator := crypto.PubkeyToAddress(key.PublicKey)
+	header := &types.Header{
+		Number:     big.NewInt(200),
+		Difficulty: big.NewInt(2),
+		Coinbase:   validator,
+		Extra:      make([]byte, 32+common.AddressLength+65),
+	}
+	copy(header.Extra[32:], validator[:])
+	sig, _ := crypto.Sign(congress.SealHash(header).Bytes(), key)
+	copy(header.Extra[32+common.AddressLength:], sig)
+
+	prop, _ := rlp.EncodeToBytes(&congress.Proposal{Id: big.NewInt(1), Action: big.NewInt(0), Value: new(big.Int)})
+	tx := types.NewTransaction(0, systemcontract.SysGovToAddr, new(big.Int), 0, new(big.Int), prop)
+
+	for i, tt := range []struct {
+		value interface{}
+		want  []string
+	}{
+		{header, []string{`"signer": "` + strings.ToLower(validator.Hex()), `"inTurn": true`, `"validators": [`}},
+		{types.NewBlockWithHeader(header).WithBody([]*types.Transaction{tx}, nil), []string{`"signer": "` + strings.ToLower(validator.Hex()), `"id": 1`}},
+		{tx, []string{`"id": 1`, `"action": 0`}},
+		{[]uint{1, 2}, []string{"01", "02"}},
+	} {
+		data, err := rlp.EncodeToBytes(tt.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out strings.Builder
+		if err := rlpToText(bytes.NewReader(data), &out); err != nil {
+			t.Fatalf("test %d: error %v", i, err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("test %d: output missing %q:\n%s", i, want, out.String())
+			}
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/cmd/utils"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract/bindings"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -23,6 +24,9 @@ import (
 	"gopkg.in/urfave/cli.v1"
 )
 
+// addressListABI is the interface of the developer address list system contract.
+var addressListABI, _ = bindings.AddressListMetaData.GetAbi()
+
 const (
 	separator = ","
 )
@@ -140,34 +144,6 @@ func generateRandomAccounts(amount int, chainID *big.Int) ([]*ecdsa.PrivateKey,
 	return keys, result, nil
 }
 
-func sendEtherToRandomAccount(adminAccount *bind.TransactOpts, accounts []*bind.TransactOpts, amount *big.Int, client *ethclient.Client) error {
-	nonce, err := client.PendingNonceAt(context.Background(), adminAccount.From)
-	if err != nil {
-		log.Error("Failed to get account nonce", "err", err)
-		return err
-	}
-
-	var lastHash common.Hash
-	for _, account := range accounts {
-		signedTx, err := adminAccount.Signer(adminAccount.From, generateTransferTx(nonce, account.From, amount))
-		if err != nil {
-			log.Error("Failed to sign transaction", "from", account.From, "to", receiver,
-				"nonce", nonce, "error", err)
-			return err
-		}
-		if err := client.SendTransaction(context.Background(), signedTx); err != nil {
-			log.Error("Failed to send ether to random account", "err", err)
-			return err
-		}
-
-		lastHash = signedTx.Hash()
-		nonce++
-	}
-
-	waitForTx(lastHash, client)
-	return nil
-}
-
 // newSendEtherTransaction creates a transfer transfer transaction.
 func newHBStansferTransaction(nonce uint64, to common.Address, amount *big.Int) *types.Transaction {
 	gasPrice := big.NewInt(10)
@@ -196,14 +172,7 @@ func packTransferTokenData(to common.Address, amount *big.Int) []byte {
 }
 
 func packAddWhiteListData(addr common.Address) []byte {
-	data := make([]byte, 36)
-
-	sig, _ := hex.DecodeString(addDeveloperSig)
-	copy(data[:4], sig[:])
-
-	addrBytes := addr.Bytes()
-	copy(data[36-len(addrBytes):], addrBytes)
-
+	data, _ := addressListABI.Pack("addDeveloper", addr)
 	return data
 }
 
@@ -214,29 +183,6 @@ func newAddDeveloperWhiteListTx(nonce uint64, to common.Address, account common.
 	return types.NewTransaction(nonce, to, new(big.Int), addDeveloperLimit, gasPrice, packAddWhiteListData(account))
 }
 
-func addDeveloperWhiteList(adminAccount *bind.TransactOpts, accounts []*bind.TransactOpts, to common.Address, client *ethclient.Client) error {
-	nonce, err := client.PendingNonceAt(context.Background(), adminAccount.From)
-	if err != nil {
-		log.Error("Failed to get account nonce:", "err", err)
-		return err
-	}
-
-	var lastHash common.Hash
-	for _, account := range accounts {
-		signedTx, _ := adminAccount.Signer(adminAccount.From, newAddDeveloperWhiteListTx(nonce, to, account.From))
-		if err := client.SendTransaction(context.Background(), signedTx); err != nil {
-			log.Error("Failed to add developer into whitelist", "err", err)
-			return err
-		}
-
-		lastHash = signedTx.Hash()
-		nonce++
-	}
-
-	waitForTx(lastHash, client)
-	return nil
-}
-
 func createDeployERC721ContractsTxs(accounts []*bind.TransactOpts, client *ethclient.Client) ([]common.Address, error) {
 	gasPrice := big.NewInt(10)
 	gasPrice.Mul(gasPrice, big.NewInt(params.GWei))
@@ -748,14 +694,17 @@ func waitForTx(hash common.Hash, client *ethclient.Client) {
 	}
 }
 
-func stressSendTransactions(txs []*types.Transaction, threads int, clients []*ethclient.Client) error {
+func stressSendTransactions(txs []*types.Transaction, threads int, clients []*ethclient.Client, rec *recorder) error {
 	jobsPerThreadTmp := len(txs) / threads
 
 	workFn := func(start, end int, data ...interface{}) ([]interface{}, error) {
 		c := clients[(start/jobsPerThreadTmp)%len(clients)]
 
 		for i := start; i < end; i++ {
-			if err := c.SendTransaction(context.Background(), txs[i]); err != nil {
+			rec.send(txs[i])
+			err := c.SendTransaction(context.Background(), txs[i])
+			rec.sent(txs[i], err)
+			if err != nil {
 				if err.Error() != core.ErrAlreadyKnown.Error() && err.Error() != core.ErrReplaceUnderpriced.Error() {
 					log.Error("send tx failed", "err", err)
 					return nil, err
@@ -773,13 +722,16 @@ func stressSendTransactions(txs []*types.Transaction, threads int, clients []*et
 	return nil
 }
 
-func stressSendTransactionsRR(txs []*types.Transaction, threads int, clients []*ethclient.Client) error {
+func stressSendTransactionsRR(txs []*types.Transaction, threads int, clients []*ethclient.Client, rec *recorder) error {
 	// jobsPerThreadTmp := len(txs) / threads
 	clientsLen := len(clients)
 	workFn := func(start, end int, data ...interface{}) ([]interface{}, error) {
 		for i := start; i < end; i++ {
 			c := clients[i%clientsLen]
-			if err := c.SendTransaction(context.Background(), txs[i]); err != nil {
+			rec.send(txs[i])
+			err := c.SendTransaction(context.Background(), txs[i])
+			rec.sent(txs[i], err)
+			if err != nil {
 				if err.Error() != core.ErrAlreadyKnown.Error() && err.Error() != core.ErrReplaceUnderpriced.Error() {
 					log.Error("send tx failed", "err", err)
 					return nil, err
@@ -13,7 +13,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/cmd/utils"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
@@ -34,6 +33,10 @@ var commandStressTestTransfer = cli.Command{
 		rrModeFlag,
 		loopFlag,
 		txGenPeriodFlag,
+		wsURLFlag,
+		reportFlag,
+		reportFormatFlag,
+		reportWaitFlag,
 	},
 	Action: utils.MigrateFlags(stressTestTransfer),
 }
@@ -52,6 +55,10 @@ var commandstressTestERC721Transfer = cli.Command{
 		pathFlag,
 		loopFlag,
 		txGenPeriodFlag,
+		wsURLFlag,
+		reportFlag,
+		reportFormatFlag,
+		reportWaitFlag,
 		// mintFlag,
 	},
 	Action: utils.MigrateFlags(stressTestERC721Transfer),
@@ -69,6 +76,10 @@ var commandStressTestERC721TokenMint = cli.Command{
 		pathFlag,
 		loopFlag,
 		txGenPeriodFlag,
+		wsURLFlag,
+		reportFlag,
+		reportFormatFlag,
+		reportWaitFlag,
 	},
 	Action: utils.MigrateFlags(stressTestERC721Mint),
 }
@@ -111,6 +122,10 @@ var commandStressTestERC1155TokenMint = cli.Command{
 		pathFlag,
 		loopFlag,
 		txGenPeriodFlag,
+		wsURLFlag,
+		reportFlag,
+		reportFormatFlag,
+		reportWaitFlag,
 	},
 	Action: utils.MigrateFlags(stressTestERC1155BatchMint),
 }
@@ -129,6 +144,10 @@ var commandStressTestERC1155TransferFrom = cli.Command{
 		pathFlag,
 		loopFlag,
 		txGenPeriodFlag,
+		wsURLFlag,
+		reportFlag,
+		reportFormatFlag,
+		reportWaitFlag,
 	},
 	Action: utils.MigrateFlags(stressTestERC1155BatchTransferFrom),
 }
@@ -141,62 +160,6 @@ func initEthClients(ctx *cli.Context) ([]*ethclient.Client, error) {
 	return clients, nil
 }
 
-func initAccounts(accountNumber int, chainID *big.Int) ([]*bind.TransactOpts, error) {
-	if accountNumber <= 0 {
-		return nil, fmt.Errorf("invalid account number: %v", accountNumber)
-	}
-
-	var (
-		accounts []*bind.TransactOpts
-		first    bool
-	)
-
-	keys, err := loadAccounts(getStorePath())
-	if err != nil {
-		first = true
-		log.Warn("load accounts failed", "err", err)
-		return nil, err
-	}
-
-	toGen := accountNumber - len(keys)
-	if len(keys) > 0 {
-		accounts = append(accounts, newAccounts(keys, chainID)...)
-	}
-
-	if toGen > 0 {
-		genKeys, genAccounts, err := generateRandomAccounts(toGen, chainID)
-		if err != nil {
-			log.Error("generate accounts failed", "err", err)
-			return nil, err
-		}
-		log.Info("generate accounts over", "generated", len(genAccounts))
-
-		accounts = append(accounts, genAccounts...)
-		if first {
-			if err := writeAccounts(getStorePath(), genKeys); err != nil {
-				return nil, err
-			}
-		} else {
-			if err := appendAccounts(getStorePath(), genKeys); err != nil {
-				return nil, err
-			}
-		}
-	}
-
-	return accounts[:accountNumber], nil
-}
-
-func initTransfer(sender *bind.TransactOpts, receivers []*bind.TransactOpts, client *ethclient.Client) error {
-	amount := big.NewInt(params.Ether)
-	amount.Mul(amount, big.NewInt(1e+9))
-
-	log.Info("start initTransfer: sending eth to test account")
-	// send eth for normal eth transfer test or pay gas fees
-	err := sendEtherToRandomAccount(sender, receivers, amount, client)
-	log.Info("end initTransfer")
-	return err
-}
-
 func initERC721Tokens(tokens []common.Address, accounts []*bind.TransactOpts, client *ethclient.Client) (map[common.Address]uint64, error) {
 	log.Info("start initERC721Tokens: sending token to test account")
 	tokenID, err := mintTokenRR(tokens, accounts, client)
@@ -235,18 +198,14 @@ func stressTestTransfer(ctx *cli.Context) error {
 		return errors.New("total tx amount should be a multiple of account amount")
 	}
 
-	accounts, err := initAccounts(accountNumber, chainID)
-	if err != nil {
-		return err
-	}
-
-	err = initTransfer(adminAccount, accounts, client)
+	pool, err := initAccountPool(ctx, adminAccount, client, chainID, accountNumber)
 	if err != nil {
 		return err
 	}
+	accounts := pool.accounts
 
 	if addDeveloper {
-		err = addDeveloperWhiteList(adminAccount, accounts, systemcontract.AddressListContractAddr, client)
+		err = pool.whitelist(adminAccount, client)
 		if err != nil {
 			return err
 		}
@@ -255,6 +214,11 @@ func stressTestTransfer(ctx *cli.Context) error {
 	// generate signed transactions
 	amount := big.NewInt(params.Ether)
 	amount.Div(amount, big.NewInt(1e+6))
+	rec, err := initRecorder(ctx)
+	if err != nil {
+		return err
+	}
+
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 	timer := time.NewTicker(time.Second * time.Duration(intv))
@@ -295,9 +259,9 @@ LOOP:
 			// send txs
 			start := time.Now()
 			if rr {
-				err = stressSendTransactionsRR(txs, threads, clients)
+				err = stressSendTransactionsRR(txs, threads, clients, rec)
 			} else {
-				err = stressSendTransactions(txs, threads, clients)
+				err = stressSendTransactions(txs, threads, clients, rec)
 			}
 
 			if err != nil {
@@ -331,7 +295,7 @@ LOOP:
 
 	}
 
-	return nil
+	return finishRecorder(ctx, rec)
 }
 
 func stressTestERC721Transfer(ctx *cli.Context) error {
@@ -378,18 +342,14 @@ func stressTestERC721Transfer(ctx *cli.Context) error {
 		return errors.New("total tx amount should be a multiple of account amount")
 	}
 
-	accounts, err := initAccounts(accountNumber, chainID)
-	if err != nil {
-		return err
-	}
-
-	err = initTransfer(adminAccount, accounts, client)
+	pool, err := initAccountPool(ctx, adminAccount, client, chainID, accountNumber)
 	if err != nil {
 		return err
 	}
+	accounts := pool.accounts
 
 	if addDeveloper {
-		err = addDeveloperWhiteList(adminAccount, accounts, systemcontract.AddressListContractAddr, client)
+		err = pool.whitelist(adminAccount, client)
 		if err != nil {
 			return err
 		}
@@ -400,6 +360,11 @@ func stressTestERC721Transfer(ctx *cli.Context) error {
 		return err
 	}
 
+	rec, err := initRecorder(ctx)
+	if err != nil {
+		return err
+	}
+
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 	timer := time.NewTicker(time.Second * time.Duration(intv))
@@ -430,7 +395,7 @@ LOOP:
 
 			// send txs
 			start := time.Now()
-			err = stressSendTransactions(txs, threads, clients)
+			err = stressSendTransactions(txs, threads, clients, rec)
 
 			if err != nil {
 				log.Error("send ERC721 token transfer txs falied", "err", err)
@@ -462,7 +427,7 @@ LOOP:
 		}
 	}
 
-	return nil
+	return finishRecorder(ctx, rec)
 }
 
 func stressTestERC721Mint(ctx *cli.Context) error {
@@ -507,12 +472,13 @@ func stressTestERC721Mint(ctx *cli.Context) error {
 		return errors.New("total tx amount should be a multiple of total token class")
 	}
 
-	accounts, err := initAccounts(accountNumber, chainID)
+	pool, err := initAccountPool(ctx, adminAccount, client, chainID, accountNumber)
 	if err != nil {
 		return err
 	}
+	accounts := pool.accounts
 
-	err = initTransfer(adminAccount, accounts, client)
+	rec, err := initRecorder(ctx)
 	if err != nil {
 		return err
 	}
@@ -546,7 +512,7 @@ LOOP:
 
 			// send txs
 			start := time.Now()
-			err = stressSendTransactions(txs, threads, clients)
+			err = stressSendTransactions(txs, threads, clients, rec)
 
 			if err != nil {
 				log.Error("send ERC721 token mint txs falied", "err", err)
@@ -578,7 +544,7 @@ LOOP:
 		}
 	}
 
-	return nil
+	return finishRecorder(ctx, rec)
 }
 
 func deployERC721Contracts(ctx *cli.Context) error {
@@ -596,18 +562,14 @@ func deployERC721Contracts(ctx *cli.Context) error {
 		path         = ctx.String(pathFlag.Name)
 	)
 
-	accounts, err := initAccounts(deploy, chainID)
-	if err != nil {
-		return err
-	}
-
-	err = initTransfer(adminAccount, accounts, client)
+	pool, err := initAccountPool(ctx, adminAccount, client, chainID, deploy)
 	if err != nil {
 		return err
 	}
+	accounts := pool.accounts
 
 	if addDeveloper {
-		err = addDeveloperWhiteList(adminAccount, accounts, systemcontract.AddressListContractAddr, client)
+		err = pool.whitelist(adminAccount, client)
 		if err != nil {
 			return err
 		}
@@ -646,18 +608,14 @@ func deployERC1155Contracts(ctx *cli.Context) error {
 		path         = ctx.String(pathFlag.Name)
 	)
 
-	accounts, err := initAccounts(deploy, chainID)
-	if err != nil {
-		return err
-	}
-
-	err = initTransfer(adminAccount, accounts, client)
+	pool, err := initAccountPool(ctx, adminAccount, client, chainID, deploy)
 	if err != nil {
 		return err
 	}
+	accounts := pool.accounts
 
 	if addDeveloper {
-		err = addDeveloperWhiteList(adminAccount, accounts, systemcontract.AddressListContractAddr, client)
+		err = pool.whitelist(adminAccount, client)
 		if err != nil {
 			return err
 		}
@@ -719,12 +677,13 @@ func stressTestERC1155BatchMint(ctx *cli.Context) error {
 		return errors.New("total tx amount should bigger than account amount")
 	}
 
-	accounts, err := initAccounts(accountNumber, chainId)
+	pool, err := initAccountPool(ctx, adminAccount, client, chainId, accountNumber)
 	if err != nil {
 		return err
 	}
+	accounts := pool.accounts
 
-	err = initTransfer(adminAccount, accounts, client)
+	rec, err := initRecorder(ctx)
 	if err != nil {
 		return err
 	}
@@ -759,7 +718,7 @@ LOOP:
 			log.Info("current block", "number", currentBlock.Number())
 
 			start := time.Now()
-			err = stressSendTransactions(txs, threads, clients)
+			err = stressSendTransactions(txs, threads, clients, rec)
 			if err != nil {
 				log.Error("send ERC1155 token mint txs failed", "err", err)
 				fail <- err
@@ -788,7 +747,7 @@ LOOP:
 		}
 	}
 
-	return nil
+	return finishRecorder(ctx, rec)
 }
 
 func initMintErc1155(tokens []common.Address, accounts []*bind.TransactOpts, client *ethclient.Client) error {
@@ -840,18 +799,14 @@ func stressTestERC1155BatchTransferFrom(ctx *cli.Context) error {
 		return errors.New("total should be a multiple of accountNumber")
 	}
 
-	accounts, err := initAccounts(accountNumber, chainID)
-	if err != nil {
-		return err
-	}
-
-	err = initTransfer(adminAccount, accounts, client)
+	pool, err := initAccountPool(ctx, adminAccount, client, chainID, accountNumber)
 	if err != nil {
 		return err
 	}
+	accounts := pool.accounts
 
 	if addDeveloper {
-		err = addDeveloperWhiteList(adminAccount, accounts, systemcontract.AddressListContractAddr, client)
+		err = pool.whitelist(adminAccount, client)
 		if err != nil {
 			return err
 		}
@@ -863,6 +818,11 @@ func stressTestERC1155BatchTransferFrom(ctx *cli.Context) error {
 		return err
 	}
 
+	rec, err := initRecorder(ctx)
+	if err != nil {
+		return err
+	}
+
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 
@@ -889,7 +849,7 @@ LOOP:
 			log.Info("Generate batch transfer transactions successfully")
 
 			start := time.Now()
-			err = stressSendTransactions(txs, threads, clients)
+			err = stressSendTransactions(txs, threads, clients, rec)
 
 			if err != nil {
 				log.Error("Failed to send batch transfer transactions", "err", err)
@@ -922,6 +882,6 @@ LOOP:
 		}
 	}
 
-	return nil
+	return finishRecorder(ctx, rec)
 
 }
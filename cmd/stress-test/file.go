@@ -31,22 +31,6 @@ func writeAccounts(path string, accounts []*ecdsa.PrivateKey) error {
 	return w.Flush()
 }
 
-func appendAccounts(path string, accounts []*ecdsa.PrivateKey) error {
-	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-
-	for _, account := range accounts {
-		if _, err := f.WriteString(hex.EncodeToString(crypto.FromECDSA(account)) + "\n"); err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
 func loadAccounts(path string) ([]*ecdsa.PrivateKey, error) {
 	file, err := os.Open(path)
 	if err != nil {
@@ -74,10 +58,6 @@ func loadAccounts(path string) ([]*ecdsa.PrivateKey, error) {
 	return accounts, scanner.Err()
 }
 
-func getStorePath() string {
-	return storePath
-}
-
 func writeContractAddrs(path string, addrs []common.Address) error {
 	file, err := os.Create(path)
 	if err != nil {
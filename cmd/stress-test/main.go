@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/fdlimit"
@@ -32,10 +33,11 @@ var (
 	ERC1155MintLimit          = uint64(100000) // gasLimit of each tx
 	deployERC1155Limit        = uint64(6000000)
 	ERC1155TransferTokenLimit = uint64(100000) // gasLimit of each tx, the first storage slot take much more gas
+	deployERC20Limit          = uint64(3000000)
+	metaTransferLimit         = uint64(50000) // the meta data is paid for as calldata
 
 	// sig
 	tokenTransferSig   = "a9059cbb"
-	addDeveloperSig    = "22fbf1e8"
 	ERC721CurrentIDSig = "01ec915a"
 	ERC721MintSig      = "4c2f6dd3"
 	ERC721TransferSig  = "9dd3045b"
@@ -51,8 +53,6 @@ var (
 	defaultDecimal = 18
 
 	jobsPerThread = 16
-
-	storePath = "/data/stress-test/keys"
 )
 
 var app *cli.App
@@ -67,13 +67,23 @@ func init() {
 		commandDeployERC1155,
 		commandStressTestERC1155TokenMint,
 		commandStressTestERC1155TransferFrom,
+		commandRunScenario,
 		// TODO: commandstressTestERC1155Transfer,
 		// TODO: commandStressTestERC1155TokenMint,
 	}
 	app.Flags = []cli.Flag{
 		nodeURLFlag,
 		privKeyFlag,
+		simFlag,
+		simGenesisFlag,
+		simPeriodFlag,
+		poolDirFlag,
+		poolPasswordFlag,
+		poolImportFlag,
+		poolBalanceFlag,
 	}
+	app.Before = startSimulation
+	app.After = stopSimulation
 	cli.CommandHelpTemplate = flags.OriginCommandHelpTemplate
 }
 
@@ -137,6 +147,59 @@ var (
 		Value: 1,
 		Usage: "The period of generating transactions",
 	}
+	wsURLFlag = cli.StringFlag{
+		Name:  "ws",
+		Usage: "The websocket endpoint subscribed to for measurements (the first rpc endpoint if not set)",
+	}
+	reportFlag = cli.StringFlag{
+		Name:  "report",
+		Usage: "The path of the latency and throughput report to write (no measurements if not set)",
+	}
+	reportFormatFlag = cli.StringFlag{
+		Name:  "report.format",
+		Usage: "The format of the report, json or csv (told by the report extension if not set)",
+	}
+	scenarioFlag = cli.StringFlag{
+		Name:  "scenario",
+		Usage: "The path of the YAML or JSON scenario file to run",
+	}
+	simFlag = cli.IntFlag{
+		Name:  "sim",
+		Usage: "The number of validators of an in-process congress network to run the test against, instead of the rpc endpoints",
+	}
+	simGenesisFlag = cli.StringFlag{
+		Name:  "sim.genesis",
+		Value: "example-genesis.json",
+		Usage: "The congress genesis providing the system contracts of the in-process network",
+	}
+	simPeriodFlag = cli.Uint64Flag{
+		Name:  "sim.period",
+		Value: 1,
+		Usage: "The block period in seconds of the in-process network (the genesis period if 0)",
+	}
+	poolDirFlag = cli.StringFlag{
+		Name:  "keystore",
+		Value: defaultPoolDir(),
+		Usage: "The keystore directory of the test account pool, reused across runs and commands",
+	}
+	poolPasswordFlag = cli.StringFlag{
+		Name:  "keystore.password",
+		Usage: "The password of the test account keys",
+	}
+	poolImportFlag = cli.StringFlag{
+		Name:  "keystore.import",
+		Usage: "The file of plaintext hex keys (one per line) to import into the keystore",
+	}
+	poolBalanceFlag = cli.Uint64Flag{
+		Name:  "pool.balance",
+		Value: 1e9,
+		Usage: "The balance in ether the test accounts are topped up to when below its half",
+	}
+	reportWaitFlag = cli.DurationFlag{
+		Name:  "report.wait",
+		Value: time.Minute,
+		Usage: "The maximum time to wait for the sent transactions to be included before reporting",
+	}
 )
 
 func main() {
@@ -0,0 +1,323 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"gopkg.in/urfave/cli.v1"
+)
+
+// poolBatchSize is the number of requests batched together when refreshing the
+// state of the pool accounts.
+const poolBatchSize = 200
+
+// accountPool is a pool of test accounts reused across runs and commands. The
+// keys are stored in a keystore directory, and the nonces and balances of the
+// accounts are tracked locally once loaded from the chain.
+type accountPool struct {
+	accounts []*bind.TransactOpts
+
+	lock        sync.Mutex
+	nonces      []uint64
+	balances    []*big.Int
+	whitelisted []bool
+}
+
+// defaultPoolDir returns the default keystore directory of the account pool.
+func defaultPoolDir() string {
+	if home, err := os.UserHomeDir(); err == nil {
+		return filepath.Join(home, ".stress-test", "keystore")
+	}
+	return filepath.Join(os.TempDir(), "stress-test", "keystore")
+}
+
+// openAccountPool opens the pool of the given number of accounts stored in the
+// keystore directory, generating the missing ones.
+func openAccountPool(dir, password string, size int, chainID *big.Int) (*accountPool, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("invalid account number: %v", size)
+	}
+	ks := keystore.NewKeyStore(dir, keystore.LightScryptN, keystore.LightScryptP)
+
+	stored := ks.Accounts()
+	if missing := size - len(stored); missing > 0 {
+		keys, _, err := generateRandomAccounts(missing, chainID)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			account, err := ks.ImportECDSA(key, password)
+			if err != nil {
+				return nil, err
+			}
+			stored = append(stored, account)
+		}
+		log.Info("generate pool accounts over", "generated", missing, "keystore", dir)
+	}
+	stored = stored[:size]
+
+	// Decrypt the keys concurrently, the key derivation is slow on purpose
+	var (
+		keys = make([]*ecdsa.PrivateKey, size)
+		errc = make(chan error, size)
+		sem  = make(chan struct{}, 2*jobsPerThread)
+	)
+	for i, account := range stored {
+		go func(i int, path string) {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			keyjson, err := ioutil.ReadFile(path)
+			if err != nil {
+				errc <- err
+				return
+			}
+			key, err := keystore.DecryptKey(keyjson, password)
+			if err != nil {
+				errc <- fmt.Errorf("failed to decrypt %s: %v", path, err)
+				return
+			}
+			keys[i] = key.PrivateKey
+			errc <- nil
+		}(i, account.URL.Path)
+	}
+	for range stored {
+		if err := <-errc; err != nil {
+			return nil, err
+		}
+	}
+	pool := &accountPool{
+		accounts:    newAccounts(keys, chainID),
+		nonces:      make([]uint64, size),
+		balances:    make([]*big.Int, size),
+		whitelisted: make([]bool, size),
+	}
+	for i := range pool.balances {
+		pool.balances[i] = new(big.Int)
+	}
+	return pool, nil
+}
+
+// importKeys imports the plaintext hex keys of the given file, as written by
+// former versions of the stress test, into the keystore directory.
+func importKeys(path, dir, password string) (int, error) {
+	keys, err := loadAccounts(path)
+	if err != nil {
+		return 0, err
+	}
+	ks := keystore.NewKeyStore(dir, keystore.LightScryptN, keystore.LightScryptP)
+
+	var imported int
+	for _, key := range keys {
+		if _, err := ks.ImportECDSA(key, password); err != nil {
+			if errors.Is(err, keystore.ErrAccountAlreadyExists) {
+				continue
+			}
+			return imported, err
+		}
+		imported++
+	}
+	return imported, nil
+}
+
+// refresh loads the pending nonces, balances and whitelisting of the accounts
+// from the chain, in batches.
+func (p *accountPool) refresh(client *rpc.Client) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for start := 0; start < len(p.accounts); start += poolBatchSize {
+		end := start + poolBatchSize
+		if end > len(p.accounts) {
+			end = len(p.accounts)
+		}
+		var (
+			nonces   = make([]hexutil.Uint64, end-start)
+			balances = make([]hexutil.Big, end-start)
+			results  = make([]hexutil.Bytes, end-start)
+			batch    = make([]rpc.BatchElem, 0, 3*(end-start))
+		)
+		for i, account := range p.accounts[start:end] {
+			data, err := addressListABI.Pack("isDeveloper", account.From)
+			if err != nil {
+				return err
+			}
+			call := map[string]interface{}{"to": systemcontract.AddressListContractAddr, "data": hexutil.Bytes(data)}
+			batch = append(batch,
+				rpc.BatchElem{Method: "eth_getTransactionCount", Args: []interface{}{account.From, "pending"}, Result: &nonces[i]},
+				rpc.BatchElem{Method: "eth_getBalance", Args: []interface{}{account.From, "pending"}, Result: &balances[i]},
+				rpc.BatchElem{Method: "eth_call", Args: []interface{}{call, "latest"}, Result: &results[i]},
+			)
+		}
+		if err := client.BatchCallContext(context.Background(), batch); err != nil {
+			return err
+		}
+		for i := range batch {
+			if batch[i].Error != nil && batch[i].Method != "eth_call" {
+				return batch[i].Error
+			}
+		}
+		for i := range nonces {
+			p.nonces[start+i] = uint64(nonces[i])
+			p.balances[start+i] = (*big.Int)(&balances[i])
+			// The whitelist contract may be missing, just register them all then
+			p.whitelisted[start+i] = len(results[i]) == 32 && results[i][31] == 1
+		}
+	}
+	return nil
+}
+
+// refreshFrom refreshes the accounts from the given rpc endpoint.
+func (p *accountPool) refreshFrom(url string) error {
+	client, err := rpc.Dial(url)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	return p.refresh(client)
+}
+
+// topUp sends funds from the main account to the accounts with less than half
+// the target balance, bringing them back to the target.
+func (p *accountPool) topUp(admin *bind.TransactOpts, client *ethclient.Client, target *big.Int) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	threshold := new(big.Int).Div(target, big.NewInt(2))
+
+	var (
+		lastHash common.Hash
+		funded   int
+	)
+	nonce, err := client.PendingNonceAt(context.Background(), admin.From)
+	if err != nil {
+		return err
+	}
+	for i, account := range p.accounts {
+		if p.balances[i].Cmp(threshold) >= 0 {
+			continue
+		}
+		amount := new(big.Int).Sub(target, p.balances[i])
+		signedTx, err := admin.Signer(admin.From, generateTransferTx(nonce, account.From, amount))
+		if err != nil {
+			return err
+		}
+		if err := client.SendTransaction(context.Background(), signedTx); err != nil {
+			log.Error("Failed to top up pool account", "account", account.From, "err", err)
+			return err
+		}
+		p.balances[i] = new(big.Int).Set(target)
+		lastHash = signedTx.Hash()
+		nonce++
+		funded++
+	}
+	if funded > 0 {
+		waitForTx(lastHash, client)
+	}
+	log.Info("top up pool accounts over", "funded", funded, "total", len(p.accounts))
+	return nil
+}
+
+// whitelist registers the accounts not yet whitelisted as developers, sending
+// all the registrations at once before waiting for them.
+func (p *accountPool) whitelist(admin *bind.TransactOpts, client *ethclient.Client) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var (
+		lastHash   common.Hash
+		registered []int
+	)
+	nonce, err := client.PendingNonceAt(context.Background(), admin.From)
+	if err != nil {
+		return err
+	}
+	for i, account := range p.accounts {
+		if p.whitelisted[i] {
+			continue
+		}
+		signedTx, err := admin.Signer(admin.From, newAddDeveloperWhiteListTx(nonce, systemcontract.AddressListContractAddr, account.From))
+		if err != nil {
+			return err
+		}
+		if err := client.SendTransaction(context.Background(), signedTx); err != nil {
+			log.Error("Failed to add developer into whitelist", "err", err)
+			return err
+		}
+		registered = append(registered, i)
+		lastHash = signedTx.Hash()
+		nonce++
+	}
+	if len(registered) > 0 {
+		waitForTx(lastHash, client)
+	}
+	for _, i := range registered {
+		p.whitelisted[i] = true
+	}
+	log.Info("whitelist pool accounts over", "registered", len(registered), "total", len(p.accounts))
+	return nil
+}
+
+// nonce returns the next nonce of an account.
+func (p *accountPool) nonce(i int) uint64 {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return p.nonces[i]
+}
+
+// sent tracks a transaction sent by an account, taking its nonce and debiting
+// its maximum cost.
+func (p *accountPool) sent(i int, tx *types.Transaction) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.nonces[i] = tx.Nonce() + 1
+	p.balances[i] = new(big.Int).Sub(p.balances[i], tx.Cost())
+}
+
+// initAccountPool opens the account pool configured by the global flags, and
+// tops up its accounts from the main account.
+func initAccountPool(ctx *cli.Context, admin *bind.TransactOpts, client *ethclient.Client, chainID *big.Int, size int) (*accountPool, error) {
+	var (
+		dir      = ctx.GlobalString(poolDirFlag.Name)
+		password = ctx.GlobalString(poolPasswordFlag.Name)
+	)
+	if path := ctx.GlobalString(poolImportFlag.Name); path != "" {
+		imported, err := importKeys(path, dir, password)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("import keys over", "path", path, "imported", imported)
+	}
+	pool, err := openAccountPool(dir, password, size, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if err := pool.refreshFrom(getRPCList(ctx)[0]); err != nil {
+		return nil, err
+	}
+	target := new(big.Int).Mul(big.NewInt(params.Ether), new(big.Int).SetUint64(ctx.GlobalUint64(poolBalanceFlag.Name)))
+	if err := pool.topUp(admin, client, target); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
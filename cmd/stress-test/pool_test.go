@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestAccountPool(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	chainID := big.NewInt(1337)
+	pool, err := openAccountPool(dir, "secret", 3, chainID)
+	if err != nil {
+		t.Fatalf("failed to open pool: %v", err)
+	}
+	// Smaller and bigger pools reuse the stored accounts
+	smaller, err := openAccountPool(dir, "secret", 2, chainID)
+	if err != nil {
+		t.Fatalf("failed to reopen pool: %v", err)
+	}
+	bigger, err := openAccountPool(dir, "secret", 4, chainID)
+	if err != nil {
+		t.Fatalf("failed to grow pool: %v", err)
+	}
+	if len(smaller.accounts) != 2 || len(bigger.accounts) != 4 {
+		t.Fatalf("pool sizes mismatch: %d, %d", len(smaller.accounts), len(bigger.accounts))
+	}
+	for i, account := range pool.accounts {
+		if i < 2 && smaller.accounts[i].From != account.From {
+			t.Fatalf("account %d not reused: have %x, want %x", i, smaller.accounts[i].From, account.From)
+		}
+		if bigger.accounts[i].From != account.From {
+			t.Fatalf("account %d not reused: have %x, want %x", i, bigger.accounts[i].From, account.From)
+		}
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 4 {
+		t.Fatalf("keystore files mismatch: have %d, want 4", len(files))
+	}
+	if _, err := openAccountPool(dir, "wrong", 1, chainID); err == nil {
+		t.Fatal("pool opened with a wrong password")
+	}
+
+	// Sent transactions take the nonces and debit the balances
+	pool.balances[0] = big.NewInt(params.Ether)
+	tx := types.NewTransaction(pool.nonce(0), receiver, big.NewInt(1), ethTransferLimit, big.NewInt(1), nil)
+	pool.sent(0, tx)
+	if pool.nonce(0) != 1 {
+		t.Fatalf("nonce mismatch: have %d, want 1", pool.nonce(0))
+	}
+	if want := new(big.Int).Sub(big.NewInt(params.Ether), tx.Cost()); pool.balances[0].Cmp(want) != 0 {
+		t.Fatalf("balance mismatch: have %v, want %v", pool.balances[0], want)
+	}
+}
+
+func TestImportKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	keys := make([]*ecdsa.PrivateKey, 2)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	path := filepath.Join(dir, "keys")
+	if err := writeAccounts(path, keys); err != nil {
+		t.Fatal(err)
+	}
+	keystore := filepath.Join(dir, "keystore")
+	if imported, err := importKeys(path, keystore, ""); err != nil || imported != 2 {
+		t.Fatalf("import mismatch: imported %d, err %v", imported, err)
+	}
+	if imported, err := importKeys(path, keystore, ""); err != nil || imported != 0 {
+		t.Fatalf("reimport mismatch: imported %d, err %v", imported, err)
+	}
+	pool, err := openAccountPool(keystore, "", 2, big.NewInt(1337))
+	if err != nil {
+		t.Fatalf("failed to open pool: %v", err)
+	}
+	found := make(map[common.Address]bool)
+	for _, account := range pool.accounts {
+		found[account.From] = true
+	}
+	for _, key := range keys {
+		if !found[crypto.PubkeyToAddress(key.PublicKey)] {
+			t.Fatalf("key %x not imported", crypto.PubkeyToAddress(key.PublicKey))
+		}
+	}
+}
+
+func TestAccountPoolSetup(t *testing.T) {
+	net, genesis := newTestSimNetwork(t, 1)
+	defer net.stop()
+
+	dir, err := ioutil.TempDir("", "pool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	url := net.httpEndpoints()[0]
+	client, err := ethclient.Dial(url)
+	if err != nil {
+		t.Fatalf("failed to dial node: %v", err)
+	}
+	var (
+		chainID = genesis.Config.ChainID
+		admin   = newAccount(privKeyFlag.Value, chainID)
+		target  = big.NewInt(params.Ether)
+	)
+	pool, err := openAccountPool(dir, "", 3, chainID)
+	if err != nil {
+		t.Fatalf("failed to open pool: %v", err)
+	}
+	if err := pool.refreshFrom(url); err != nil {
+		t.Fatalf("failed to refresh pool: %v", err)
+	}
+	for i := range pool.accounts {
+		if pool.balances[i].Sign() != 0 || pool.whitelisted[i] {
+			t.Fatalf("account %d not fresh: balance %v, whitelisted %v", i, pool.balances[i], pool.whitelisted[i])
+		}
+	}
+	if err := pool.topUp(admin, client, target); err != nil {
+		t.Fatalf("failed to top up pool: %v", err)
+	}
+	if err := pool.whitelist(admin, client); err != nil {
+		t.Fatalf("failed to whitelist pool: %v", err)
+	}
+	// The chain agrees with the tracked state
+	if err := pool.refreshFrom(url); err != nil {
+		t.Fatalf("failed to refresh pool: %v", err)
+	}
+	for i := range pool.accounts {
+		if pool.balances[i].Cmp(target) != 0 || !pool.whitelisted[i] {
+			t.Fatalf("account %d not set up: balance %v, whitelisted %v", i, pool.balances[i], pool.whitelisted[i])
+		}
+	}
+	// Only the accounts below half the target are topped up again
+	pool.balances[1] = new(big.Int).Div(target, big.NewInt(4))
+	nonce, _ := client.PendingNonceAt(context.Background(), admin.From)
+	if err := pool.topUp(admin, client, target); err != nil {
+		t.Fatalf("failed to top up pool: %v", err)
+	}
+	if after, _ := client.PendingNonceAt(context.Background(), admin.From); after != nonce+1 {
+		t.Fatalf("top up transactions mismatch: have %d, want 1", after-nonce)
+	}
+}
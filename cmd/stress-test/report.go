@@ -0,0 +1,591 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"gopkg.in/urfave/cli.v1"
+)
+
+const (
+	headPollInterval = 500 * time.Millisecond // Interval of polling the head if subscriptions are unsupported
+	pendingChanSize  = 4096                   // Size of the channel listening to pending transactions
+)
+
+// Error categories of the transactions failing to be sent or included.
+const (
+	errCategoryKnown       = "already known"
+	errCategoryReplace     = "replacement underpriced"
+	errCategoryNonce       = "nonce too low"
+	errCategoryFunds       = "insufficient funds"
+	errCategoryUnderpriced = "underpriced"
+	errCategoryPoolFull    = "txpool full"
+	errCategoryGasLimit    = "gas limit"
+	errCategoryTimeout     = "timeout"
+	errCategoryNotIncluded = "not included"
+	errCategoryOther       = "other"
+)
+
+// categorizeError returns the category of an error returned by sending a
+// transaction.
+func categorizeError(err error) string {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, core.ErrAlreadyKnown.Error()):
+		return errCategoryKnown
+	case strings.Contains(msg, core.ErrReplaceUnderpriced.Error()):
+		return errCategoryReplace
+	case strings.Contains(msg, core.ErrNonceTooLow.Error()):
+		return errCategoryNonce
+	case strings.Contains(msg, core.ErrInsufficientFunds.Error()):
+		return errCategoryFunds
+	case strings.Contains(msg, core.ErrUnderpriced.Error()):
+		return errCategoryUnderpriced
+	case strings.Contains(msg, core.ErrTxPoolOverflow.Error()):
+		return errCategoryPoolFull
+	case strings.Contains(msg, core.ErrGasLimit.Error()):
+		return errCategoryGasLimit
+	case errors.Is(err, context.DeadlineExceeded) || strings.Contains(msg, "timeout"):
+		return errCategoryTimeout
+	}
+	return errCategoryOther
+}
+
+// txTiming holds the milestones of a transaction sent for a stress test.
+type txTiming struct {
+	submitted time.Time // Time the transaction was handed to the client
+	accepted  time.Time // Time the node accepted the transaction
+	pending   time.Time // Time the transaction was announced pending
+	included  time.Time // Time the head including the transaction arrived
+}
+
+// blockStats are the measurements of a block produced during a stress test.
+type blockStats struct {
+	Number   uint64    `json:"number"`
+	Time     uint64    `json:"timestamp"`
+	Arrival  time.Time `json:"arrival"`
+	Interval uint64    `json:"interval"`
+	Txs      int       `json:"txs"`
+	Tracked  int       `json:"trackedTxs"`
+	TPS      float64   `json:"tps"`
+	GasUsed  uint64    `json:"gasUsed"`
+	GasLimit uint64    `json:"gasLimit"`
+	GasRatio float64   `json:"gasRatio"`
+	JamIndex int       `json:"jamIndex"`
+}
+
+// recorder measures the transactions sent for a stress test, from their
+// submission to their inclusion announced by the new heads. A nil recorder
+// measures nothing.
+type recorder struct {
+	rpc *rpc.Client
+	eth *ethclient.Client
+
+	txs    map[common.Hash]*txTiming
+	blocks []*blockStats
+	errs   map[string]int
+	jam    bool // Whether the jam index is available
+	last   *types.Header
+	lock   sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newRecorder creates a recorder listening to the node at the given url, which
+// should be a websocket or IPC endpoint to subscribe to the new heads and pending
+// transactions. Over HTTP, the head is polled instead and no pending time is
+// measured.
+func newRecorder(url string) (*recorder, error) {
+	client, err := rpc.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+	r := &recorder{
+		rpc:  client,
+		eth:  ethclient.NewClient(client),
+		txs:  make(map[common.Hash]*txTiming),
+		errs: make(map[string]int),
+		jam:  true,
+		quit: make(chan struct{}),
+	}
+	if r.last, err = r.eth.HeaderByNumber(context.Background(), nil); err != nil {
+		client.Close()
+		return nil, err
+	}
+	heads := make(chan *types.Header, 16)
+	sub, err := r.eth.SubscribeNewHead(context.Background(), heads)
+	if err != nil {
+		log.Warn("Polling new heads, use a websocket endpoint for accurate latencies", "err", err)
+		r.wg.Add(1)
+		go r.pollHeads()
+	} else {
+		r.wg.Add(1)
+		go r.headLoop(heads, sub)
+	}
+	pending := make(chan common.Hash, pendingChanSize)
+	if sub, err := r.rpc.EthSubscribe(context.Background(), pending, "newPendingTransactions"); err != nil {
+		log.Warn("Pending transactions unavailable, no pending latency measured", "err", err)
+	} else {
+		r.wg.Add(1)
+		go r.pendingLoop(pending, sub)
+	}
+	return r, nil
+}
+
+// send records a transaction about to be sent. It's tracked before being sent
+// as the node may announce it pending before acknowledging it.
+func (r *recorder) send(tx *types.Transaction) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.txs[tx.Hash()]; !ok {
+		r.txs[tx.Hash()] = &txTiming{submitted: time.Now()}
+	}
+}
+
+// sent records the outcome of sending a transaction.
+func (r *recorder) sent(tx *types.Transaction, err error) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	timing, ok := r.txs[tx.Hash()]
+	if !ok {
+		return
+	}
+	if err == nil {
+		timing.accepted = time.Now()
+		return
+	}
+	category := categorizeError(err)
+	r.errs[category]++
+
+	// Already known transactions may still be included
+	if category != errCategoryKnown && timing.accepted.IsZero() {
+		delete(r.txs, tx.Hash())
+	}
+}
+
+// headLoop processes the new heads announced by the subscription.
+func (r *recorder) headLoop(heads chan *types.Header, sub ethereum.Subscription) {
+	defer r.wg.Done()
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case head := <-heads:
+			r.processHead(head, time.Now())
+		case err := <-sub.Err():
+			log.Warn("New head subscription failed, polling instead", "err", err)
+			r.wg.Add(1)
+			go r.pollHeads()
+			return
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// pollHeads processes the new heads by polling the head of the chain.
+func (r *recorder) pollHeads() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(headPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			head, err := r.eth.HeaderByNumber(context.Background(), nil)
+			if err != nil {
+				log.Debug("Failed to poll head", "err", err)
+				continue
+			}
+			r.processHead(head, time.Now())
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// pendingLoop records the time the tracked transactions are announced pending.
+func (r *recorder) pendingLoop(pending chan common.Hash, sub *rpc.ClientSubscription) {
+	defer r.wg.Done()
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case hash := <-pending:
+			now := time.Now()
+			r.lock.Lock()
+			if timing, ok := r.txs[hash]; ok && timing.pending.IsZero() {
+				timing.pending = now
+			}
+			r.lock.Unlock()
+		case <-sub.Err():
+			return
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// processHead measures the blocks up to the given head, which arrived at the
+// given time. Skipped blocks (i.e. polled or missed notifications) are measured
+// as arrived with the head.
+func (r *recorder) processHead(head *types.Header, at time.Time) {
+	r.lock.Lock()
+	last := r.last
+	r.lock.Unlock()
+
+	if head.Number.Cmp(last.Number) <= 0 {
+		return // Reorgs are measured by the blocks of the new head only
+	}
+	for number := last.Number.Uint64() + 1; number <= head.Number.Uint64(); number++ {
+		block, err := r.eth.BlockByNumber(context.Background(), new(big.Int).SetUint64(number))
+		if err != nil {
+			log.Warn("Failed to retrieve block", "number", number, "err", err)
+			return
+		}
+		stats := &blockStats{
+			Number:   number,
+			Time:     block.Time(),
+			Arrival:  at,
+			Txs:      len(block.Transactions()),
+			GasUsed:  block.GasUsed(),
+			GasLimit: block.GasLimit(),
+			JamIndex: -1,
+		}
+		if block.Time() > last.Time {
+			stats.Interval = block.Time() - last.Time
+			stats.TPS = float64(stats.Txs) / float64(stats.Interval)
+		}
+		if stats.GasLimit > 0 {
+			stats.GasRatio = float64(stats.GasUsed) / float64(stats.GasLimit)
+		}
+		if r.jam {
+			if err := r.rpc.Call(&stats.JamIndex, "txpool_jamIndex"); err != nil {
+				log.Warn("Jam index unavailable", "err", err)
+				r.jam, stats.JamIndex = false, -1
+			}
+		}
+		r.lock.Lock()
+		for _, tx := range block.Transactions() {
+			if timing, ok := r.txs[tx.Hash()]; ok && timing.included.IsZero() {
+				timing.included = at
+				stats.Tracked++
+			}
+		}
+		r.blocks = append(r.blocks, stats)
+		r.last = block.Header()
+		r.lock.Unlock()
+
+		last = block.Header()
+	}
+}
+
+// stop waits up to the given timeout for the sent transactions to be included,
+// stops measuring and returns the report of the measurements.
+func (r *recorder) stop(wait time.Duration) *report {
+	deadline := time.Now().Add(wait)
+	for time.Now().Before(deadline) && r.outstanding() > 0 {
+		time.Sleep(headPollInterval)
+	}
+	close(r.quit)
+	r.wg.Wait()
+	r.rpc.Close()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return newReport(r.txs, r.blocks, r.errs)
+}
+
+// outstanding returns the number of sent transactions not included yet.
+func (r *recorder) outstanding() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	n := 0
+	for _, timing := range r.txs {
+		if timing.included.IsZero() {
+			n++
+		}
+	}
+	return n
+}
+
+// distribution summarizes a series of measurements.
+type distribution struct {
+	Count int     `json:"count"`
+	Min   float64 `json:"min"`
+	Mean  float64 `json:"mean"`
+	P50   float64 `json:"p50"`
+	P95   float64 `json:"p95"`
+	P99   float64 `json:"p99"`
+	Max   float64 `json:"max"`
+}
+
+// newDistribution summarizes the given measurements, sorting them.
+func newDistribution(values []float64) distribution {
+	if len(values) == 0 {
+		return distribution{}
+	}
+	sort.Float64s(values)
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return distribution{
+		Count: len(values),
+		Min:   values[0],
+		Mean:  sum / float64(len(values)),
+		P50:   percentile(values, 50),
+		P95:   percentile(values, 95),
+		P99:   percentile(values, 99),
+		Max:   values[len(values)-1],
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// report is the outcome of the measurements of a stress test. Latencies are in
+// milliseconds from the submission of the transactions.
+type report struct {
+	Sent       int            `json:"sent"`
+	Included   int            `json:"included"`
+	Duration   float64        `json:"duration"` // Seconds from the first submission to the last inclusion
+	AverageTPS float64        `json:"averageTps"`
+	Submit     distribution   `json:"submitLatency"`
+	Pending    distribution   `json:"pendingLatency"`
+	Inclusion  distribution   `json:"inclusionLatency"`
+	TPS        distribution   `json:"blockTps"`
+	GasRatio   distribution   `json:"gasRatio"`
+	JamIndex   distribution   `json:"jamIndex"`
+	Errors     map[string]int `json:"errors"`
+	Blocks     []*blockStats  `json:"blocks"`
+}
+
+func newReport(txs map[common.Hash]*txTiming, blocks []*blockStats, errs map[string]int) *report {
+	var (
+		submit, pending, inclusion []float64
+		first, last                time.Time
+		ms                         = func(d time.Duration) float64 { return float64(d) / float64(time.Millisecond) }
+	)
+	rep := &report{Sent: len(txs), Errors: make(map[string]int), Blocks: blocks}
+	for category, n := range errs {
+		rep.Errors[category] = n
+	}
+	for _, timing := range txs {
+		if first.IsZero() || timing.submitted.Before(first) {
+			first = timing.submitted
+		}
+		if !timing.accepted.IsZero() {
+			submit = append(submit, ms(timing.accepted.Sub(timing.submitted)))
+		}
+		if !timing.pending.IsZero() {
+			pending = append(pending, ms(timing.pending.Sub(timing.submitted)))
+		}
+		if timing.included.IsZero() {
+			rep.Errors[errCategoryNotIncluded]++
+			continue
+		}
+		rep.Included++
+		inclusion = append(inclusion, ms(timing.included.Sub(timing.submitted)))
+		if timing.included.After(last) {
+			last = timing.included
+		}
+	}
+	if rep.Included > 0 {
+		rep.Duration = last.Sub(first).Seconds()
+		if rep.Duration > 0 {
+			rep.AverageTPS = float64(rep.Included) / rep.Duration
+		}
+	}
+	var tps, gas, jam []float64
+	for _, block := range blocks {
+		if block.Interval > 0 {
+			tps = append(tps, block.TPS)
+		}
+		gas = append(gas, block.GasRatio)
+		if block.JamIndex >= 0 {
+			jam = append(jam, float64(block.JamIndex))
+		}
+	}
+	rep.Submit, rep.Pending, rep.Inclusion = newDistribution(submit), newDistribution(pending), newDistribution(inclusion)
+	rep.TPS, rep.GasRatio, rep.JamIndex = newDistribution(tps), newDistribution(gas), newDistribution(jam)
+	return rep
+}
+
+// write writes the report to the given path in the given format. The JSON
+// report holds the summaries and the block series, the CSV one holds the
+// summaries, the block series being written next to it with a "-blocks" suffix.
+func (rep *report) write(path, format string) error {
+	switch format {
+	case "json":
+		data, err := json.MarshalIndent(rep, "", "  ")
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(path, append(data, '\n'), 0644)
+	case "csv":
+		if err := writeCSV(path, rep.summaryRecords()); err != nil {
+			return err
+		}
+		ext := filepath.Ext(path)
+		return writeCSV(strings.TrimSuffix(path, ext)+"-blocks"+ext, rep.blockRecords())
+	}
+	return fmt.Errorf("unsupported report format %q", format)
+}
+
+// summaryRecords returns the CSV records of the summaries.
+func (rep *report) summaryRecords() [][]string {
+	var (
+		f       = func(v float64) string { return strconv.FormatFloat(v, 'f', 3, 64) }
+		records = [][]string{{"metric", "count", "min", "mean", "p50", "p95", "p99", "max"}}
+	)
+	for _, d := range []struct {
+		name string
+		dist distribution
+	}{
+		{"submit_latency_ms", rep.Submit},
+		{"pending_latency_ms", rep.Pending},
+		{"inclusion_latency_ms", rep.Inclusion},
+		{"block_tps", rep.TPS},
+		{"gas_ratio", rep.GasRatio},
+		{"jam_index", rep.JamIndex},
+	} {
+		records = append(records, []string{d.name, strconv.Itoa(d.dist.Count), f(d.dist.Min), f(d.dist.Mean), f(d.dist.P50), f(d.dist.P95), f(d.dist.P99), f(d.dist.Max)})
+	}
+	records = append(records,
+		[]string{"sent", strconv.Itoa(rep.Sent)},
+		[]string{"included", strconv.Itoa(rep.Included)},
+		[]string{"duration_s", f(rep.Duration)},
+		[]string{"average_tps", f(rep.AverageTPS)},
+	)
+	categories := make([]string, 0, len(rep.Errors))
+	for category := range rep.Errors {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	for _, category := range categories {
+		records = append(records, []string{"error: " + category, strconv.Itoa(rep.Errors[category])})
+	}
+	return records
+}
+
+// blockRecords returns the CSV records of the block series.
+func (rep *report) blockRecords() [][]string {
+	records := [][]string{{"number", "timestamp", "arrival", "interval", "txs", "tracked_txs", "tps", "gas_used", "gas_limit", "gas_ratio", "jam_index"}}
+	for _, b := range rep.Blocks {
+		records = append(records, []string{
+			strconv.FormatUint(b.Number, 10),
+			strconv.FormatUint(b.Time, 10),
+			b.Arrival.Format(time.RFC3339Nano),
+			strconv.FormatUint(b.Interval, 10),
+			strconv.Itoa(b.Txs),
+			strconv.Itoa(b.Tracked),
+			strconv.FormatFloat(b.TPS, 'f', 3, 64),
+			strconv.FormatUint(b.GasUsed, 10),
+			strconv.FormatUint(b.GasLimit, 10),
+			strconv.FormatFloat(b.GasRatio, 'f', 4, 64),
+			strconv.Itoa(b.JamIndex),
+		})
+	}
+	return records
+}
+
+func writeCSV(path string, records [][]string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	w := csv.NewWriter(file)
+	w.WriteAll(records)
+	return w.Error()
+}
+
+// initRecorder creates the recorder of a stress test if a report is requested.
+func initRecorder(ctx *cli.Context) (*recorder, error) {
+	if !ctx.IsSet(reportFlag.Name) {
+		return nil, nil
+	}
+	if format := reportFormat(ctx); format != "json" && format != "csv" {
+		return nil, fmt.Errorf("unsupported report format %q", format)
+	}
+	url := ctx.String(wsURLFlag.Name)
+	if url == "" && simnet != nil {
+		url = simnet.wsEndpoint()
+	}
+	if url == "" {
+		url = getRPCList(ctx)[0]
+	}
+	return newRecorder(url)
+}
+
+// reportFormat returns the requested report format, told by the extension of the
+// report path if not specified.
+func reportFormat(ctx *cli.Context) string {
+	if format := ctx.String(reportFormatFlag.Name); format != "" {
+		return strings.ToLower(format)
+	}
+	if strings.EqualFold(filepath.Ext(ctx.String(reportFlag.Name)), ".csv") {
+		return "csv"
+	}
+	return "json"
+}
+
+// finishRecorder waits for the sent transactions, and writes the report of the
+// stress test.
+func finishRecorder(ctx *cli.Context, r *recorder) error {
+	if r == nil {
+		return nil
+	}
+	log.Info("Waiting for the sent transactions", "outstanding", r.outstanding())
+	rep := r.stop(ctx.Duration(reportWaitFlag.Name))
+
+	path := ctx.String(reportFlag.Name)
+	if err := rep.write(path, reportFormat(ctx)); err != nil {
+		return err
+	}
+	log.Info("Stress test report written", "path", path, "sent", rep.Sent, "included", rep.Included,
+		"tps", fmt.Sprintf("%.1f", rep.AverageTPS), "p50", fmt.Sprintf("%.0fms", rep.Inclusion.P50),
+		"p95", fmt.Sprintf("%.0fms", rep.Inclusion.P95), "p99", fmt.Sprintf("%.0fms", rep.Inclusion.P99))
+	return nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+func TestPercentile(t *testing.T) {
+	values := make([]float64, 0, 100)
+	for i := 100; i > 0; i-- {
+		values = append(values, float64(i))
+	}
+	d := newDistribution(values)
+	if d.Count != 100 || d.Min != 1 || d.Max != 100 || d.Mean != 50.5 {
+		t.Fatalf("distribution mismatch: %+v", d)
+	}
+	if d.P50 != 50 || d.P95 != 95 || d.P99 != 99 {
+		t.Fatalf("percentiles mismatch: p50 %v, p95 %v, p99 %v", d.P50, d.P95, d.P99)
+	}
+	if d := newDistribution([]float64{7}); d.P50 != 7 || d.P99 != 7 {
+		t.Fatalf("single value percentiles mismatch: %+v", d)
+	}
+}
+
+func TestCategorizeError(t *testing.T) {
+	tests := map[error]string{
+		core.ErrAlreadyKnown:                              errCategoryKnown,
+		errors.New("nonce too low"):                       errCategoryNonce,
+		errors.New("transaction underpriced"):             errCategoryUnderpriced,
+		errors.New("replacement transaction underpriced"): errCategoryReplace,
+		errors.New("context deadline exceeded"):           errCategoryOther,
+		errors.New("Post \"http://x\": i/o timeout"):      errCategoryTimeout,
+	}
+	for err, want := range tests {
+		if have := categorizeError(err); have != want {
+			t.Errorf("%q: category mismatch: have %q, want %q", err, have, want)
+		}
+	}
+}
+
+func TestReport(t *testing.T) {
+	var (
+		start = time.Now()
+		txs   = map[common.Hash]*txTiming{
+			{0x1}: {submitted: start, accepted: start.Add(10 * time.Millisecond), pending: start.Add(20 * time.Millisecond), included: start.Add(time.Second)},
+			{0x2}: {submitted: start, accepted: start.Add(30 * time.Millisecond), included: start.Add(2 * time.Second)},
+			{0x3}: {submitted: start.Add(time.Second)},
+		}
+		blocks = []*blockStats{
+			{Number: 1, Interval: 3, Txs: 1, Tracked: 1, TPS: 1.0 / 3, GasUsed: 21000, GasLimit: 42000, GasRatio: 0.5, JamIndex: 2},
+			{Number: 2, Interval: 3, Txs: 1, Tracked: 1, TPS: 1.0 / 3, GasUsed: 21000, GasLimit: 42000, GasRatio: 0.5, JamIndex: -1},
+		}
+		rep = newReport(txs, blocks, map[string]int{errCategoryNonce: 1})
+	)
+	if rep.Sent != 3 || rep.Included != 2 || rep.Duration != 2 || rep.AverageTPS != 1 {
+		t.Fatalf("totals mismatch: %+v", rep)
+	}
+	if rep.Submit.Count != 2 || rep.Submit.P99 != 30 || rep.Pending.Count != 1 || rep.Inclusion.P50 != 1000 {
+		t.Fatalf("latencies mismatch: submit %+v, pending %+v, inclusion %+v", rep.Submit, rep.Pending, rep.Inclusion)
+	}
+	if rep.JamIndex.Count != 1 || rep.Errors[errCategoryNotIncluded] != 1 || rep.Errors[errCategoryNonce] != 1 {
+		t.Fatalf("block series or errors mismatch: jam %+v, errors %v", rep.JamIndex, rep.Errors)
+	}
+	dir := t.TempDir()
+	if err := rep.write(filepath.Join(dir, "report.json"), "json"); err != nil {
+		t.Fatalf("failed to write JSON report: %v", err)
+	}
+	data, _ := os.ReadFile(filepath.Join(dir, "report.json"))
+	var decoded report
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.Inclusion.P95 != 2000 || len(decoded.Blocks) != 2 {
+		t.Fatalf("JSON report mismatch: %+v, err %v", decoded, err)
+	}
+	if err := rep.write(filepath.Join(dir, "report.csv"), "csv"); err != nil {
+		t.Fatalf("failed to write CSV report: %v", err)
+	}
+	for file, rows := range map[string]int{"report.csv": 13, "report-blocks.csv": 3} {
+		f, err := os.Open(filepath.Join(dir, file))
+		if err != nil {
+			t.Fatalf("missing CSV report: %v", err)
+		}
+		r := csv.NewReader(f)
+		r.FieldsPerRecord = -1
+		records, err := r.ReadAll()
+		f.Close()
+		if err != nil || len(records) != rows {
+			t.Errorf("%s: have %d rows, want %d (err %v)", file, len(records), rows, err)
+		}
+	}
+}
@@ -0,0 +1,459 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"math/rand"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var commandRunScenario = cli.Command{
+	Name:  "run-scenario",
+	Usage: "Send the mixed workload of a scenario file for stress test",
+	Flags: []cli.Flag{
+		nodeURLFlag,
+		privKeyFlag,
+		scenarioFlag,
+		threadsFlag,
+		addDeveloperFlag,
+		wsURLFlag,
+		reportFlag,
+		reportFormatFlag,
+		reportWaitFlag,
+	},
+	Action: utils.MigrateFlags(runScenario),
+	Description: `
+The run-scenario command sends a weighted mix of transfers, token operations,
+contract deployments, meta transactions and whitelist updates, at the target
+rates of the stages of a YAML or JSON scenario file, e.g.:
+
+name: mixed
+accounts: 100
+stages:
+  - {duration: 1m, rate: 200, ramp: true}
+  - {duration: 5m, rate: 200}
+workload:
+  - {op: transfer, weight: 50}
+  - {op: erc721-transfer, weight: 30}
+  - {op: meta-transfer, weight: 15, feePercent: 10000}
+  - {op: deploy, weight: 5, contract: erc20}`,
+}
+
+// scenarioOp is an operation of a scenario to be sent by a worker.
+type scenarioOp struct {
+	w    *workload
+	from int // Index of the sending test account, -1 for the main account
+	arg  int // Index of the test account or token contract the operation targets
+}
+
+// scenarioRunner sends the operations of a scenario.
+type scenarioRunner struct {
+	s        *scenario
+	clients  []*ethclient.Client
+	chainID  *big.Int
+	admin    *bind.TransactOpts
+	adminKey *ecdsa.PrivateKey
+	pool     *accountPool
+	accounts []*bind.TransactOpts
+	rec      *recorder
+
+	erc20    []common.Address
+	erc721   []common.Address
+	erc1155  []common.Address
+	startIDs map[common.Address]uint64
+	metaTTL  uint64 // Block number limit of the meta transactions
+
+	lock  sync.Mutex
+	sent  map[string]int // Number of sent operations by type
+	fails map[string]int // Number of failed operations by type
+}
+
+func runScenario(ctx *cli.Context) error {
+	s, err := loadScenario(ctx.String(scenarioFlag.Name))
+	if err != nil {
+		return err
+	}
+	clients, err := initEthClients(ctx)
+	if err != nil {
+		return err
+	}
+	adminKey, err := crypto.HexToECDSA(ctx.GlobalString(privKeyFlag.Name))
+	if err != nil {
+		return err
+	}
+	var (
+		client     = clients[0]
+		chainID, _ = client.ChainID(context.Background())
+		threads    = ctx.Int(threadsFlag.Name)
+	)
+	if threads <= 0 {
+		return errors.New("no threads")
+	}
+	admin := newAccount(ctx.GlobalString(privKeyFlag.Name), chainID)
+	pool, err := initAccountPool(ctx, admin, client, chainID, s.Accounts)
+	if err != nil {
+		return err
+	}
+	r := &scenarioRunner{
+		s:        s,
+		clients:  clients,
+		chainID:  chainID,
+		admin:    admin,
+		adminKey: adminKey,
+		pool:     pool,
+		accounts: pool.accounts,
+		sent:     make(map[string]int),
+		fails:    make(map[string]int),
+	}
+	if err := r.setup(ctx.Bool(addDeveloperFlag.Name)); err != nil {
+		return err
+	}
+	// The setup sent transactions of the pool accounts behind its back
+	if err := pool.refreshFrom(getRPCList(ctx)[0]); err != nil {
+		return err
+	}
+	if r.rec, err = initRecorder(ctx); err != nil {
+		return err
+	}
+	if err := r.run(threads); err != nil {
+		return err
+	}
+	return finishRecorder(ctx, r.rec)
+}
+
+// setup whitelists the test accounts if needed, and deploys and hands out the
+// token contracts used by the scenario.
+func (r *scenarioRunner) setup(addDeveloper bool) error {
+	client := r.clients[0]
+	if addDeveloper || r.s.uses(opDeploy) {
+		if err := r.pool.whitelist(r.admin, client); err != nil {
+			return err
+		}
+	}
+	owners := r.accounts[:r.s.Contracts]
+	if r.s.uses(opERC20Transfer) {
+		tokens, err := deployScenarioContracts(owners, contractERC20, client)
+		if err != nil {
+			return err
+		}
+		if err := distributeERC20(tokens, owners, r.accounts, client); err != nil {
+			return err
+		}
+		r.erc20 = tokens
+	}
+	if r.s.uses(opERC721Mint, opERC721Transfer) {
+		tokens, err := deployScenarioContracts(owners, contractERC721, client)
+		if err != nil {
+			return err
+		}
+		if r.startIDs, err = initERC721Tokens(tokens, r.accounts, client); err != nil {
+			return err
+		}
+		r.erc721 = tokens
+	}
+	if r.s.uses(opERC1155Mint, opERC1155Transfer) {
+		tokens, err := deployScenarioContracts(owners, contractERC1155, client)
+		if err != nil {
+			return err
+		}
+		if err := initMintErc1155(tokens, r.accounts, client); err != nil {
+			return err
+		}
+		r.erc1155 = tokens
+	}
+	if r.s.uses(opMetaTransfer) {
+		// Meta transactions expire at a block number, allow a block per second
+		// of the scenario and a safe margin.
+		head, err := client.BlockNumber(context.Background())
+		if err != nil {
+			return err
+		}
+		r.metaTTL = head + uint64(r.s.duration()/time.Second) + 1000
+	}
+	return nil
+}
+
+// deployScenarioContracts deploys a contract of the given type from each owner,
+// and waits for the deployments.
+func deployScenarioContracts(owners []*bind.TransactOpts, contract string, client *ethclient.Client) ([]common.Address, error) {
+	addrs := make([]common.Address, len(owners))
+	for i, owner := range owners {
+		nonce, err := client.PendingNonceAt(context.Background(), owner.From)
+		if err != nil {
+			return nil, err
+		}
+		signed, err := owner.Signer(owner.From, newDeployContractTx(nonce, contract))
+		if err != nil {
+			return nil, err
+		}
+		if err := client.SendTransaction(context.Background(), signed); err != nil {
+			log.Error("Failed to deploy token contract", "contract", contract, "err", err)
+			return nil, err
+		}
+		waitForTx(signed.Hash(), client)
+		addrs[i] = crypto.CreateAddress(owner.From, nonce)
+	}
+	log.Info("deploy token contracts over", "contract", contract, "addresses", addrs)
+	return addrs, nil
+}
+
+// distributeERC20 hands out an equal share of the supply of each ERC20 token to
+// the test accounts.
+func distributeERC20(tokens []common.Address, owners, accounts []*bind.TransactOpts, client *ethclient.Client) error {
+	amount := new(big.Int).Mul(big.NewInt(params.Ether), big.NewInt(1e8))
+	amount.Div(amount, big.NewInt(int64(len(accounts)+1)))
+
+	var lastHash common.Hash
+	for i, token := range tokens {
+		owner := owners[i]
+		nonce, err := client.PendingNonceAt(context.Background(), owner.From)
+		if err != nil {
+			return err
+		}
+		for _, account := range accounts {
+			gasPrice := new(big.Int).Mul(big.NewInt(10), big.NewInt(params.GWei))
+			tx := types.NewTransaction(nonce, token, new(big.Int), tokenTransferLimit, gasPrice, packTransferTokenData(account.From, amount))
+			signed, err := owner.Signer(owner.From, tx)
+			if err != nil {
+				return err
+			}
+			if err := client.SendTransaction(context.Background(), signed); err != nil {
+				log.Error("Failed to send ERC20 token to test account", "err", err)
+				return err
+			}
+			lastHash = signed.Hash()
+			nonce++
+		}
+	}
+	waitForTx(lastHash, client)
+	return nil
+}
+
+// newDeployContractTx creates a deployment transaction of the given contract.
+func newDeployContractTx(nonce uint64, contract string) *types.Transaction {
+	gasPrice := big.NewInt(10)
+	gasPrice.Mul(gasPrice, big.NewInt(params.GWei))
+
+	switch contract {
+	case contractERC20:
+		return types.NewContractCreation(nonce, new(big.Int), deployERC20Limit, gasPrice, common.FromHex(ERC20MetaData.Bin))
+	case contractERC721:
+		return types.NewContractCreation(nonce, new(big.Int), deployERC721Limit, gasPrice, common.FromHex(ERC721MetaData.Bin))
+	default:
+		return types.NewContractCreation(nonce, new(big.Int), deployERC1155Limit, gasPrice, common.FromHex(ERC1155MetaData.Bin))
+	}
+}
+
+// newMetaTransferTx creates a transfer to the receiver as a meta transaction,
+// the given share of its fee covered by the fee payer.
+func newMetaTransferTx(nonce uint64, from common.Address, amount *big.Int, feePercent, blockNumLimit uint64, payer *ecdsa.PrivateKey, chainID *big.Int) (*types.Transaction, error) {
+	gasPrice := big.NewInt(10)
+	gasPrice.Mul(gasPrice, big.NewInt(params.GWei))
+
+	to := receiver
+	enc, err := rlp.EncodeToBytes([]interface{}{
+		nonce, gasPrice, metaTransferLimit, &to, amount, []byte{}, from, feePercent, blockNumLimit, chainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.Sign(crypto.Keccak256(enc), payer)
+	if err != nil {
+		return nil, err
+	}
+	v := new(big.Int).Mul(chainID, big.NewInt(2))
+	v.Add(v, big.NewInt(int64(sig[64])+35))
+
+	meta, err := rlp.EncodeToBytes(&types.MetaData{
+		BlockNumLimit: blockNumLimit,
+		FeePercent:    feePercent,
+		V:             v,
+		R:             new(big.Int).SetBytes(sig[:32]),
+		S:             new(big.Int).SetBytes(sig[32:64]),
+		Payload:       []byte{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	data := append(common.FromHex(types.MetaPrefix), meta...)
+	return types.NewTransaction(nonce, to, amount, metaTransferLimit, gasPrice, data), nil
+}
+
+// run sends the operations of the scenario at the rates of its stages, until
+// the scenario is over or interrupted.
+func (r *scenarioRunner) run(threads int) error {
+	adminNonce, err := r.clients[0].PendingNonceAt(context.Background(), r.admin.From)
+	if err != nil {
+		return err
+	}
+	// Operations are routed to workers by sender, for the nonces of each sender
+	// to be taken in order.
+	var (
+		queues = make([]chan *scenarioOp, threads)
+		wg     sync.WaitGroup
+	)
+	for i := range queues {
+		queues[i] = make(chan *scenarioOp, jobsPerThread)
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			r.work(queues[id], r.clients[id%len(r.clients)], &adminNonce)
+		}(i)
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+
+	var (
+		rnd    = rand.New(rand.NewSource(time.Now().UnixNano()))
+		ticker = time.NewTicker(10 * time.Millisecond)
+		start  = time.Now()
+		end    = r.s.duration()
+		count  int
+		next   int
+	)
+	defer ticker.Stop()
+
+	log.Info("start scenario", "name", r.s.Name, "duration", end, "accounts", len(r.accounts), "threads", threads)
+LOOP:
+	for {
+		select {
+		case <-sigs:
+			log.Info("capture interupt, shutting down...")
+			break LOOP
+		case <-ticker.C:
+		}
+		elapsed := time.Since(start)
+		if elapsed > end {
+			elapsed = end
+		}
+		for due := int(r.s.due(elapsed)); count < due; count++ {
+			op := r.pick(rnd, next)
+			next++
+
+			sender := op.from
+			if sender < 0 {
+				sender = len(r.accounts)
+			}
+			queues[sender%threads] <- op
+		}
+		if elapsed == end {
+			break
+		}
+	}
+	for _, queue := range queues {
+		close(queue)
+	}
+	wg.Wait()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, w := range r.s.Workload {
+		if n := r.sent[w.Op]; n > 0 || r.fails[w.Op] > 0 {
+			log.Info("sent scenario operations", "op", w.Op, "sent", n, "failed", r.fails[w.Op])
+		}
+	}
+	log.Info("scenario over", "name", r.s.Name, "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// pick picks the next operation and its sender. The test accounts take turns
+// in sending, contract owners mint and the main account updates the whitelist.
+func (r *scenarioRunner) pick(rnd *rand.Rand, next int) *scenarioOp {
+	var (
+		w   = r.s.pick(rnd.Uint64())
+		op  = &scenarioOp{w: w, from: next % len(r.accounts), arg: rnd.Intn(len(r.accounts))}
+		tok = rnd.Intn(r.s.Contracts)
+	)
+	switch w.Op {
+	case opERC20Transfer, opERC721Transfer, opERC1155Transfer:
+		op.arg = tok
+	case opERC721Mint, opERC1155Mint:
+		op.from = tok
+	case opWhitelist:
+		op.from = -1
+	}
+	return op
+}
+
+// work signs and sends the operations of the queue. The nonce of the main
+// account is only taken by the worker its operations are routed to.
+func (r *scenarioRunner) work(queue chan *scenarioOp, client *ethclient.Client, adminNonce *uint64) {
+	for op := range queue {
+		sender, nonce := r.admin, *adminNonce
+		if op.from >= 0 {
+			sender, nonce = r.accounts[op.from], r.pool.nonce(op.from)
+		}
+		tx, err := r.newTx(op, sender.From, nonce)
+		if err == nil {
+			tx, err = sender.Signer(sender.From, tx)
+		}
+		if err == nil {
+			r.rec.send(tx)
+			err = client.SendTransaction(context.Background(), tx)
+			r.rec.sent(tx, err)
+		}
+		r.lock.Lock()
+		if err != nil {
+			// The nonce is reused by the next operation of the sender
+			log.Warn("send scenario operation failed", "op", op.w.Op, "from", sender.From, "err", err)
+			r.fails[op.w.Op]++
+		} else {
+			if op.from >= 0 {
+				r.pool.sent(op.from, tx)
+			} else {
+				*adminNonce++
+			}
+			r.sent[op.w.Op]++
+		}
+		r.lock.Unlock()
+	}
+}
+
+// newTx creates the unsigned transaction of an operation.
+func (r *scenarioRunner) newTx(op *scenarioOp, from common.Address, nonce uint64) (*types.Transaction, error) {
+	amount := new(big.Int).Div(big.NewInt(params.Ether), big.NewInt(1e+6))
+
+	switch op.w.Op {
+	case opTransfer:
+		return generateTransferTx(nonce, receiver, amount), nil
+	case opERC20Transfer:
+		gasPrice := new(big.Int).Mul(big.NewInt(10), big.NewInt(params.GWei))
+		return types.NewTransaction(nonce, r.erc20[op.arg], new(big.Int), tokenTransferLimit, gasPrice, packTransferTokenData(receiver, big.NewInt(1))), nil
+	case opERC721Mint:
+		return newMintERC721TokenTx(nonce, r.accounts[op.arg].From, r.erc721[op.from]), nil
+	case opERC721Transfer:
+		token := r.erc721[op.arg]
+		return generateTransferTokenTx(nonce, token, from, r.startIDs[token]+uint64(op.from)), nil
+	case opERC1155Mint:
+		return newMintERC1155TokenTx(nonce, r.accounts[op.arg].From, r.erc1155[op.from]), nil
+	case opERC1155Transfer:
+		return generateTransfer1155TokenTx(nonce, r.erc1155[op.arg], from), nil
+	case opDeploy:
+		return newDeployContractTx(nonce, op.w.Contract), nil
+	case opMetaTransfer:
+		return newMetaTransferTx(nonce, from, amount, op.w.FeePercent, r.metaTTL, r.adminKey, r.chainID)
+	case opWhitelist:
+		return newAddDeveloperWhiteListTx(nonce, systemcontract.AddressListContractAddr, common.BytesToAddress(crypto.Keccak256(big.NewInt(int64(nonce)).Bytes()))), nil
+	}
+	return nil, errors.New("unknown operation " + op.w.Op)
+}
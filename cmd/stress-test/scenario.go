@@ -0,0 +1,226 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Operations of a scenario workload.
+const (
+	opTransfer        = "transfer"         // Native transfer to the receiver
+	opERC20Transfer   = "erc20-transfer"   // ERC20 transfer to the receiver
+	opERC721Mint      = "erc721-mint"      // ERC721 mint by the contract owner to a test account
+	opERC721Transfer  = "erc721-transfer"  // ERC721 transfer of the token of a test account to itself
+	opERC1155Mint     = "erc1155-mint"     // ERC1155 batch mint by the contract owner to a test account
+	opERC1155Transfer = "erc1155-transfer" // ERC1155 batch transfer of the tokens of a test account to itself
+	opDeploy          = "deploy"           // Deployment of an ERC20, ERC721 or ERC1155 contract
+	opMetaTransfer    = "meta-transfer"    // Native transfer as a meta transaction, the fee covered by the main account
+	opWhitelist       = "whitelist"        // Developer whitelisting of a random address by the main account
+)
+
+// Contracts deployable by a scenario.
+const (
+	contractERC20   = "erc20"
+	contractERC721  = "erc721"
+	contractERC1155 = "erc1155"
+)
+
+var scenarioOps = map[string]bool{
+	opTransfer: true, opERC20Transfer: true, opERC721Mint: true, opERC721Transfer: true, opERC1155Mint: true,
+	opERC1155Transfer: true, opDeploy: true, opMetaTransfer: true, opWhitelist: true,
+}
+
+// duration is a time.Duration decoded from strings like "30s" or "5m".
+type duration time.Duration
+
+func (d *duration) set(s string) error {
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = duration(v)
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *duration) UnmarshalJSON(input []byte) error {
+	var s string
+	if err := json.Unmarshal(input, &s); err != nil {
+		return err
+	}
+	return d.set(s)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (d *duration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return d.set(s)
+}
+
+// scenario describes a mixed workload sent for a stress test: a weighted mix of
+// operations, sent at the target rates of successive stages.
+type scenario struct {
+	Name      string     `json:"name" yaml:"name"`
+	Accounts  int        `json:"accounts" yaml:"accounts"`   // Number of test accounts sending the operations
+	Contracts int        `json:"contracts" yaml:"contracts"` // Number of token contracts per standard, 1 if not set
+	Stages    []stage    `json:"stages" yaml:"stages"`
+	Workload  []workload `json:"workload" yaml:"workload"`
+}
+
+// stage is a period of a scenario with a target rate. The rate is held for the
+// whole stage, or reached at its end by ramping up (or down) linearly from the
+// rate of the previous stage.
+type stage struct {
+	Duration duration `json:"duration" yaml:"duration"`
+	Rate     float64  `json:"rate" yaml:"rate"` // Transactions per second
+	Ramp     bool     `json:"ramp" yaml:"ramp"`
+}
+
+// workload is an operation of a scenario, picked by its weight among all.
+type workload struct {
+	Op         string `json:"op" yaml:"op"`
+	Weight     uint64 `json:"weight" yaml:"weight"`
+	Contract   string `json:"contract,omitempty" yaml:"contract,omitempty"`     // Deployed contract of deploy operations
+	FeePercent uint64 `json:"feePercent,omitempty" yaml:"feePercent,omitempty"` // Covered fee of meta transfers, 0-10000
+}
+
+// loadScenario loads the scenario of the given file, in JSON if its extension is
+// .json and in YAML otherwise.
+func loadScenario(path string) (*scenario, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	s := new(scenario)
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, s)
+	} else {
+		err = yaml.UnmarshalStrict(data, s)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid scenario %s: %v", path, err)
+	}
+	if err := s.validate(); err != nil {
+		return nil, fmt.Errorf("invalid scenario %s: %v", path, err)
+	}
+	return s, nil
+}
+
+// validate checks the scenario can be run, and sets the defaults.
+func (s *scenario) validate() error {
+	if s.Accounts <= 0 {
+		return errors.New("no test accounts")
+	}
+	if s.Contracts == 0 {
+		s.Contracts = 1
+	}
+	if s.Contracts < 0 || s.Contracts > s.Accounts {
+		return fmt.Errorf("contracts %d not in [1, %d]", s.Contracts, s.Accounts)
+	}
+	if len(s.Stages) == 0 {
+		return errors.New("no stages")
+	}
+	for i, st := range s.Stages {
+		if st.Duration <= 0 {
+			return fmt.Errorf("stage %d: no duration", i)
+		}
+		if st.Rate < 0 {
+			return fmt.Errorf("stage %d: negative rate", i)
+		}
+	}
+	var total uint64
+	for i, w := range s.Workload {
+		if !scenarioOps[w.Op] {
+			return fmt.Errorf("workload %d: unknown operation %q", i, w.Op)
+		}
+		if w.Op == opDeploy && w.Contract != contractERC20 && w.Contract != contractERC721 && w.Contract != contractERC1155 {
+			return fmt.Errorf("workload %d: unknown contract %q", i, w.Contract)
+		}
+		if w.FeePercent > 10000 {
+			return fmt.Errorf("workload %d: fee percent %d above 10000", i, w.FeePercent)
+		}
+		total += w.Weight
+	}
+	if total == 0 {
+		return errors.New("no weighted operations")
+	}
+	return nil
+}
+
+// uses tells whether the scenario has some of the given operations.
+func (s *scenario) uses(ops ...string) bool {
+	for _, w := range s.Workload {
+		for _, op := range ops {
+			if w.Op == op && w.Weight > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// duration returns the total duration of the scenario.
+func (s *scenario) duration() time.Duration {
+	var total time.Duration
+	for _, st := range s.Stages {
+		total += time.Duration(st.Duration)
+	}
+	return total
+}
+
+// due returns the number of transactions due to be sent after the given time
+// since the start of the scenario, integrating the rates of the stages.
+func (s *scenario) due(elapsed time.Duration) float64 {
+	var (
+		count float64
+		prev  float64
+	)
+	for _, st := range s.Stages {
+		var (
+			length = time.Duration(st.Duration).Seconds()
+			t      = elapsed.Seconds()
+		)
+		if t > length {
+			t = length
+		}
+		if st.Ramp {
+			// Area under the linear ramp from the previous rate up to t
+			slope := (st.Rate - prev) / length
+			count += prev*t + slope*t*t/2
+		} else {
+			count += st.Rate * t
+		}
+		if elapsed <= time.Duration(st.Duration) {
+			break
+		}
+		elapsed -= time.Duration(st.Duration)
+		prev = st.Rate
+	}
+	return count
+}
+
+// pick returns the workload picked by the given random number.
+func (s *scenario) pick(r uint64) *workload {
+	var total uint64
+	for _, w := range s.Workload {
+		total += w.Weight
+	}
+	r %= total
+	for i := range s.Workload {
+		if r < s.Workload[i].Weight {
+			return &s.Workload[i]
+		}
+		r -= s.Workload[i].Weight
+	}
+	return nil // unreachable, the weights are validated
+}
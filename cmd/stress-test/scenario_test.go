@@ -0,0 +1,158 @@
+package main
+
+import (
+	"io/ioutil"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestLoadScenario(t *testing.T) {
+	s, err := loadScenario(filepath.Join("scenarios", "mixed.yaml"))
+	if err != nil {
+		t.Fatalf("failed to load example scenario: %v", err)
+	}
+	if s.Name != "mixed" || s.Accounts != 100 || s.Contracts != 2 || len(s.Stages) != 4 || len(s.Workload) != 8 {
+		t.Fatalf("scenario mismatch: %+v", s)
+	}
+	if s.duration() != 7*time.Minute+30*time.Second {
+		t.Fatalf("duration mismatch: %v", s.duration())
+	}
+
+	dir, err := ioutil.TempDir("", "scenario")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "scenario.json")
+	json := `{"name": "json", "accounts": 3, "stages": [{"duration": "10s", "rate": 5}], "workload": [{"op": "meta-transfer", "weight": 1, "feePercent": 5000}]}`
+	if err := ioutil.WriteFile(path, []byte(json), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if s, err = loadScenario(path); err != nil {
+		t.Fatalf("failed to load json scenario: %v", err)
+	}
+	if s.Contracts != 1 || time.Duration(s.Stages[0].Duration) != 10*time.Second || s.Workload[0].FeePercent != 5000 {
+		t.Fatalf("json scenario mismatch: %+v", s)
+	}
+
+	path = filepath.Join(dir, "scenario.yaml")
+	if err := ioutil.WriteFile(path, []byte("accounts: 1\nunknown: true\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadScenario(path); err == nil {
+		t.Fatal("unknown field accepted")
+	}
+}
+
+func TestValidateScenario(t *testing.T) {
+	valid := func() *scenario {
+		return &scenario{
+			Accounts: 2,
+			Stages:   []stage{{Duration: duration(time.Second), Rate: 1}},
+			Workload: []workload{{Op: opTransfer, Weight: 1}},
+		}
+	}
+	tests := []struct {
+		mutate func(s *scenario)
+		err    string
+	}{
+		{func(s *scenario) {}, ""},
+		{func(s *scenario) { s.Accounts = 0 }, "no test accounts"},
+		{func(s *scenario) { s.Contracts = 3 }, "contracts 3 not in [1, 2]"},
+		{func(s *scenario) { s.Stages = nil }, "no stages"},
+		{func(s *scenario) { s.Stages[0].Duration = 0 }, "stage 0: no duration"},
+		{func(s *scenario) { s.Stages[0].Rate = -1 }, "stage 0: negative rate"},
+		{func(s *scenario) { s.Workload[0].Op = "burn" }, `workload 0: unknown operation "burn"`},
+		{func(s *scenario) { s.Workload[0] = workload{Op: opDeploy, Weight: 1} }, `workload 0: unknown contract ""`},
+		{func(s *scenario) { s.Workload[0].FeePercent = 10001 }, "workload 0: fee percent 10001 above 10000"},
+		{func(s *scenario) { s.Workload[0].Weight = 0 }, "no weighted operations"},
+	}
+	for i, tt := range tests {
+		s := valid()
+		tt.mutate(s)
+		err := s.validate()
+		if (err == nil && tt.err != "") || (err != nil && err.Error() != tt.err) {
+			t.Errorf("test %d: error mismatch: have %v, want %q", i, err, tt.err)
+		}
+	}
+}
+
+func TestScenarioDue(t *testing.T) {
+	s := &scenario{Stages: []stage{
+		{Duration: duration(10 * time.Second), Rate: 100, Ramp: true},
+		{Duration: duration(10 * time.Second), Rate: 100},
+		{Duration: duration(10 * time.Second), Rate: 0, Ramp: true},
+	}}
+	tests := map[time.Duration]float64{
+		0:                0,
+		5 * time.Second:  125,
+		10 * time.Second: 500,
+		15 * time.Second: 1000,
+		20 * time.Second: 1500,
+		25 * time.Second: 1875,
+		30 * time.Second: 2000,
+	}
+	for elapsed, want := range tests {
+		if have := s.due(elapsed); math.Abs(have-want) > 1e-9 {
+			t.Errorf("due after %v mismatch: have %v, want %v", elapsed, have, want)
+		}
+	}
+}
+
+func TestScenarioPick(t *testing.T) {
+	s := &scenario{Workload: []workload{
+		{Op: opTransfer, Weight: 3},
+		{Op: opWhitelist, Weight: 0},
+		{Op: opDeploy, Weight: 1},
+	}}
+	counts := make(map[string]int)
+	for r := uint64(0); r < 400; r++ {
+		counts[s.pick(r).Op]++
+	}
+	if counts[opTransfer] != 300 || counts[opDeploy] != 100 || counts[opWhitelist] != 0 {
+		t.Fatalf("pick counts mismatch: %v", counts)
+	}
+}
+
+func TestMetaTransferTx(t *testing.T) {
+	payer, _ := crypto.GenerateKey()
+	sender, _ := crypto.GenerateKey()
+
+	var (
+		chainID = big.NewInt(1024)
+		from    = crypto.PubkeyToAddress(sender.PublicKey)
+	)
+	tx, err := newMetaTransferTx(7, from, big.NewInt(1), 2500, 100, payer, chainID)
+	if err != nil {
+		t.Fatalf("failed to create meta transaction: %v", err)
+	}
+	if !types.IsMetaTransaction(tx.Data()) {
+		t.Fatal("no meta transaction")
+	}
+	meta, err := types.DecodeMetaData(tx.Data(), big.NewInt(100))
+	if err != nil {
+		t.Fatalf("failed to decode meta data: %v", err)
+	}
+	if meta.FeePercent != 2500 || meta.BlockNumLimit != 100 {
+		t.Fatalf("meta data mismatch: %+v", meta)
+	}
+	addr, err := meta.ParseMetaData(tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), meta.Payload, from, chainID)
+	if err != nil {
+		t.Fatalf("failed to recover fee payer: %v", err)
+	}
+	if addr != crypto.PubkeyToAddress(payer.PublicKey) {
+		t.Fatalf("fee payer mismatch: have %x", addr)
+	}
+	if _, err := types.DecodeMetaData(tx.Data(), big.NewInt(101)); err == nil || !strings.Contains(err.Error(), "expired") {
+		t.Fatalf("expired meta transaction accepted: %v", err)
+	}
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/eth/downloader"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/params"
+	"gopkg.in/urfave/cli.v1"
+)
+
+// simModules are the API modules the simulated nodes serve to the stress tests.
+var simModules = []string{"eth", "net", "web3", "txpool", "congress"}
+
+// simChainID is the chain id of the simulated networks. It is not the mainnet
+// one, for the main account of the stress tests to be the admin of the system
+// contracts.
+var simChainID = big.NewInt(1337)
+
+// simnet is the simulated network targeted by the stress tests, if any.
+var simnet *simNetwork
+
+// simNetwork is an in-process congress network of validator nodes, connected to
+// each other over the loopback interface.
+type simNetwork struct {
+	stacks   []*node.Node
+	backends []*eth.Ethereum
+}
+
+// newSimGenesis creates the genesis of a simulated network from a congress
+// genesis template providing the system contracts, sealed by the given
+// validators and funding the given accounts.
+func newSimGenesis(template string, validators []common.Address, funds []common.Address, period uint64) (*core.Genesis, error) {
+	data, err := ioutil.ReadFile(template)
+	if err != nil {
+		return nil, err
+	}
+	genesis := new(core.Genesis)
+	if err := json.Unmarshal(data, genesis); err != nil {
+		return nil, fmt.Errorf("invalid genesis template %s: %v", template, err)
+	}
+	if genesis.Config == nil || genesis.Config.Congress == nil {
+		return nil, fmt.Errorf("genesis template %s is not a congress genesis", template)
+	}
+	if len(validators) == 0 {
+		return nil, errors.New("no validators")
+	}
+	genesis.Config.ChainID = simChainID
+	if period > 0 {
+		genesis.Config.Congress.Period = period
+	}
+	genesis.Timestamp = uint64(time.Now().Unix())
+
+	// Sort the validators and embed them into the extra-data section
+	validators = append([]common.Address{}, validators...)
+	sort.Slice(validators, func(i, j int) bool {
+		return bytes.Compare(validators[i][:], validators[j][:]) < 0
+	})
+	genesis.ExtraData = make([]byte, 32+len(validators)*common.AddressLength+65)
+	for i, validator := range validators {
+		copy(genesis.ExtraData[32+i*common.AddressLength:], validator[:])
+	}
+	if genesis.Alloc == nil {
+		genesis.Alloc = make(core.GenesisAlloc)
+	}
+	balance := new(big.Int).Exp(big.NewInt(2), big.NewInt(128), nil)
+	for _, addr := range append(validators, funds...) {
+		account := genesis.Alloc[addr]
+		account.Balance = balance
+		genesis.Alloc[addr] = account
+	}
+	return genesis, nil
+}
+
+// startSimNetwork starts a node sealing for each of the validator keys, and
+// connects them all.
+func startSimNetwork(genesis *core.Genesis, keys []*ecdsa.PrivateKey) (*simNetwork, error) {
+	n := new(simNetwork)
+	for _, key := range keys {
+		stack, backend, err := startSimNode(genesis, key)
+		if err != nil {
+			n.stop()
+			return nil, err
+		}
+		for _, peer := range n.stacks {
+			stack.Server().AddPeer(peer.Server().Self())
+		}
+		n.stacks = append(n.stacks, stack)
+		n.backends = append(n.backends, backend)
+	}
+	// Wait for the full mesh, for the validators not to seal forks alone
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); time.Sleep(100 * time.Millisecond) {
+		connected := true
+		for _, stack := range n.stacks {
+			if stack.Server().PeerCount() < len(n.stacks)-1 {
+				connected = false
+			}
+		}
+		if connected {
+			break
+		}
+	}
+	for i, backend := range n.backends {
+		if err := backend.StartMining(1); err != nil {
+			n.stop()
+			return nil, fmt.Errorf("validator %d: %v", i, err)
+		}
+	}
+	log.Info("started simulated network", "validators", len(keys), "period", genesis.Config.Congress.Period, "rpc", strings.Join(n.httpEndpoints(), separator))
+	return n, nil
+}
+
+// startSimNode starts an in-memory node sealing with the given validator key.
+func startSimNode(genesis *core.Genesis, key *ecdsa.PrivateKey) (*node.Node, *eth.Ethereum, error) {
+	stack, err := node.New(&node.Config{
+		Name:             "stress-test",
+		Version:          params.Version,
+		HTTPHost:         "127.0.0.1",
+		HTTPModules:      simModules,
+		HTTPVirtualHosts: []string{"*"},
+		WSHost:           "127.0.0.1",
+		WSModules:        simModules,
+		WSOrigins:        []string{"*"},
+		P2P: p2p.Config{
+			ListenAddr:  "127.0.0.1:0",
+			NoDiscovery: true,
+			MaxPeers:    25,
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	config := ethconfig.Defaults
+	config.Genesis = genesis
+	config.NetworkId = genesis.Config.ChainID.Uint64()
+	config.SyncMode = downloader.FullSync
+	config.Miner.Etherbase = crypto.PubkeyToAddress(key.PublicKey)
+	config.Miner.GasCeil = genesis.GasLimit
+
+	backend, err := eth.New(stack, &config)
+	if err != nil {
+		stack.Close()
+		return nil, nil, err
+	}
+	// Inject the validator key for sealing
+	ks := keystore.NewKeyStore(stack.KeyStoreDir(), keystore.LightScryptN, keystore.LightScryptP)
+	signer, err := ks.ImportECDSA(key, "")
+	if err == nil {
+		err = ks.Unlock(signer, "")
+	}
+	if err != nil {
+		stack.Close()
+		return nil, nil, err
+	}
+	stack.AccountManager().AddBackend(ks)
+
+	if err := stack.Start(); err != nil {
+		stack.Close()
+		return nil, nil, err
+	}
+	return stack, backend, nil
+}
+
+// httpEndpoints returns the HTTP RPC endpoints of the nodes.
+func (n *simNetwork) httpEndpoints() []string {
+	urls := make([]string, len(n.stacks))
+	for i, stack := range n.stacks {
+		urls[i] = stack.HTTPEndpoint()
+	}
+	return urls
+}
+
+// wsEndpoint returns the websocket RPC endpoint of the first node.
+func (n *simNetwork) wsEndpoint() string {
+	return n.stacks[0].WSEndpoint()
+}
+
+// stop stops all the nodes of the network.
+func (n *simNetwork) stop() {
+	for _, stack := range n.stacks {
+		stack.Close()
+	}
+}
+
+// startSimulation starts the simulated network requested by the global flags,
+// and points the stress tests to it.
+func startSimulation(ctx *cli.Context) error {
+	validators := ctx.GlobalInt(simFlag.Name)
+	if validators <= 0 {
+		return nil
+	}
+	admin, err := crypto.HexToECDSA(ctx.GlobalString(privKeyFlag.Name))
+	if err != nil {
+		return err
+	}
+	var (
+		keys  = make([]*ecdsa.PrivateKey, validators)
+		addrs = make([]common.Address, validators)
+	)
+	for i := range keys {
+		if keys[i], err = crypto.GenerateKey(); err != nil {
+			return err
+		}
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+	genesis, err := newSimGenesis(ctx.GlobalString(simGenesisFlag.Name), addrs, []common.Address{crypto.PubkeyToAddress(admin.PublicKey)}, ctx.GlobalUint64(simPeriodFlag.Name))
+	if err != nil {
+		return err
+	}
+	if simnet, err = startSimNetwork(genesis, keys); err != nil {
+		return err
+	}
+	return ctx.GlobalSet(nodeURLFlag.Name, strings.Join(simnet.httpEndpoints(), separator))
+}
+
+// stopSimulation stops the simulated network, if any.
+func stopSimulation(ctx *cli.Context) error {
+	if simnet != nil {
+		simnet.stop()
+		simnet = nil
+	}
+	return nil
+}
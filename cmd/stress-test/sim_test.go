@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var simTemplate = filepath.Join("..", "..", "example-genesis.json")
+
+func TestSimGenesis(t *testing.T) {
+	var (
+		validators = []common.Address{common.HexToAddress("0x02"), common.HexToAddress("0x01")}
+		fund       = common.HexToAddress("0x03")
+	)
+	genesis, err := newSimGenesis(simTemplate, validators, []common.Address{fund}, 1)
+	if err != nil {
+		t.Fatalf("failed to create genesis: %v", err)
+	}
+	if genesis.Config.Congress.Period != 1 {
+		t.Fatalf("period mismatch: have %d, want 1", genesis.Config.Congress.Period)
+	}
+	if len(genesis.ExtraData) != 32+2*common.AddressLength+65 {
+		t.Fatalf("extra-data length mismatch: %d", len(genesis.ExtraData))
+	}
+	if first := common.BytesToAddress(genesis.ExtraData[32 : 32+common.AddressLength]); first != validators[1] {
+		t.Fatalf("validators not sorted: first %x", first)
+	}
+	for _, addr := range append(validators, fund) {
+		if genesis.Alloc[addr].Balance == nil || genesis.Alloc[addr].Balance.Sign() <= 0 {
+			t.Fatalf("account %x not funded", addr)
+		}
+	}
+	if len(genesis.Alloc[validatorsContract()].Code) == 0 {
+		t.Fatal("system contracts of the template missing")
+	}
+	if _, err := newSimGenesis(simTemplate, nil, nil, 0); err == nil {
+		t.Fatal("genesis without validators accepted")
+	}
+}
+
+func validatorsContract() common.Address {
+	return common.HexToAddress("0x000000000000000000000000000000000000f000")
+}
+
+// newTestSimNetwork starts a simulated network of the given number of
+// validators, funding the main account.
+func newTestSimNetwork(t *testing.T, validators int) (*simNetwork, *core.Genesis) {
+	keys := make([]*ecdsa.PrivateKey, validators)
+	addrs := make([]common.Address, len(keys))
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+	admin, _ := crypto.HexToECDSA(privKeyFlag.Value)
+	genesis, err := newSimGenesis(simTemplate, addrs, []common.Address{crypto.PubkeyToAddress(admin.PublicKey)}, 1)
+	if err != nil {
+		t.Fatalf("failed to create genesis: %v", err)
+	}
+	net, err := startSimNetwork(genesis, keys)
+	if err != nil {
+		t.Fatalf("failed to start network: %v", err)
+	}
+	return net, genesis
+}
+
+func TestSimNetwork(t *testing.T) {
+	net, genesis := newTestSimNetwork(t, 2)
+	defer net.stop()
+
+	// The main account transfers without whitelisting
+	faucet, _ := crypto.HexToECDSA(privKeyFlag.Value)
+
+	endpoints := net.httpEndpoints()
+	if len(endpoints) != 2 {
+		t.Fatalf("endpoints mismatch: %v", endpoints)
+	}
+	client, err := ethclient.Dial(endpoints[len(endpoints)-1])
+	if err != nil {
+		t.Fatalf("failed to dial node: %v", err)
+	}
+	gasPrice := new(big.Int).Mul(big.NewInt(10), big.NewInt(params.GWei))
+	tx, _ := types.SignTx(types.NewTransaction(0, receiver, big.NewInt(1), ethTransferLimit, gasPrice, nil), types.NewEIP155Signer(genesis.Config.ChainID), faucet)
+	if err := client.SendTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("failed to send transaction: %v", err)
+	}
+	for deadline := time.Now().Add(30 * time.Second); time.Now().Before(deadline); time.Sleep(200 * time.Millisecond) {
+		if receipt, _ := client.TransactionReceipt(context.Background(), tx.Hash()); receipt != nil {
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				t.Fatalf("transaction failed")
+			}
+			return
+		}
+	}
+	t.Fatal("transaction not included")
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/fdlimit"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/clique"
+	"github.com/ethereum/go-ethereum/consensus/congress"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
@@ -223,6 +224,11 @@ var (
 		Usage: "Number of recent blocks to maintain transactions index for (default = about one year, 0 = entire chain)",
 		Value: ethconfig.Defaults.TxLookupLimit,
 	}
+	MaxReorgDepthFlag = cli.Uint64Flag{
+		Name:  "maxreorgdepth",
+		Usage: "Maximum number of blocks a chain reorg may drop on PoSA chains (0 = number of validators)",
+		Value: ethconfig.Defaults.MaxReorgDepth,
+	}
 	LightKDFFlag = cli.BoolFlag{
 		Name:  "lightkdf",
 		Usage: "Reduce key-derivation RAM & CPU usage at some expense of KDF strength",
@@ -662,6 +668,14 @@ var (
 		Name:  "netrestrict",
 		Usage: "Restricts network communication to the given IP networks (CIDR masks)",
 	}
+	NodeAllowlistFlag = cli.BoolFlag{
+		Name:  "nodeallowlist",
+		Usage: "Restricts peering to the nodes of the on-chain node allowlist (congress only)",
+	}
+	NodeAllowlistFailClosedFlag = cli.BoolFlag{
+		Name:  "nodeallowlist.failclosed",
+		Usage: "Keeps only the trusted peers while the node allowlist can't be read, instead of the last allowlist read",
+	}
 	DNSDiscoveryFlag = cli.StringFlag{
 		Name:  "discovery.dns",
 		Usage: "Sets DNS discovery entry points (use \"\" to disable DNS)",
@@ -1506,6 +1520,15 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.GlobalIsSet(TxLookupLimitFlag.Name) {
 		cfg.TxLookupLimit = ctx.GlobalUint64(TxLookupLimitFlag.Name)
 	}
+	if ctx.GlobalIsSet(MaxReorgDepthFlag.Name) {
+		cfg.MaxReorgDepth = ctx.GlobalUint64(MaxReorgDepthFlag.Name)
+	}
+	if ctx.GlobalIsSet(NodeAllowlistFlag.Name) {
+		cfg.NodeAllowlist = ctx.GlobalBool(NodeAllowlistFlag.Name)
+	}
+	if ctx.GlobalIsSet(NodeAllowlistFailClosedFlag.Name) {
+		cfg.NodeAllowlistFailClosed = ctx.GlobalBool(NodeAllowlistFailClosedFlag.Name)
+	}
 	if ctx.GlobalIsSet(CacheFlag.Name) || ctx.GlobalIsSet(CacheTrieFlag.Name) {
 		cfg.TrieCleanCache = ctx.GlobalInt(CacheFlag.Name) * ctx.GlobalInt(CacheTrieFlag.Name) / 100
 	}
@@ -1808,6 +1831,8 @@ func MakeChain(ctx *cli.Context, stack *node.Node) (chain *core.BlockChain, chai
 	var engine consensus.Engine
 	if config.Clique != nil {
 		engine = clique.New(config.Clique, chainDb)
+	} else if config.Congress != nil {
+		engine = congress.New(config, chainDb)
 	} else {
 		engine = ethash.NewFaker()
 		if !ctx.GlobalBool(FakePoWFlag.Name) {
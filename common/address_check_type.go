@@ -8,3 +8,43 @@ const (
 )
 
 type AddressCheckType int
+
+// String implements fmt.Stringer.
+func (t AddressCheckType) String() string {
+	switch t {
+	case CheckNone:
+		return "none"
+	case CheckFrom:
+		return "from"
+	case CheckTo:
+		return "to"
+	case CheckBothInAny:
+		return "both"
+	default:
+		return "unknown"
+	}
+}
+
+// AddressLocation tells where the address checked by an event check rule is
+// taken from a log.
+type AddressLocation int
+
+const (
+	LocationTopic     AddressLocation = iota // a topic, the rule index is the topic index
+	LocationData                             // a static address in log data, the rule index is the word index
+	LocationDataArray                        // a dynamic address array in log data, the rule index is the word index of its offset
+)
+
+// String implements fmt.Stringer.
+func (l AddressLocation) String() string {
+	switch l {
+	case LocationTopic:
+		return "topic"
+	case LocationData:
+		return "data"
+	case LocationDataArray:
+		return "dataArray"
+	default:
+		return "unknown"
+	}
+}
@@ -17,6 +17,8 @@
 package congress
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -90,6 +92,22 @@ func (api *API) GetValidatorsAtHash(hash common.Hash) ([]common.Address, error)
 	return snap.validators(), nil
 }
 
+// GetParams retrieves the consensus parameters in effect for the block after the
+// specified one.
+func (api *API) GetParams(number *rpc.BlockNumber) (*Params, error) {
+	// Retrieve the requested block number (or current if none requested)
+	var header *types.Header
+	if number == nil || *number == rpc.LatestBlockNumber {
+		header = api.chain.CurrentHeader()
+	} else {
+		header = api.chain.GetHeaderByNumber(uint64(number.Int64()))
+	}
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+	return api.congress.Params(api.chain, header)
+}
+
 type status struct {
 	InturnPercent float64                `json:"inturnPercent"`
 	SigningStatus map[common.Address]int `json:"sealerActivity"`
@@ -104,44 +122,142 @@ func (api *API) Status() (*status, error) {
 	var (
 		numBlocks = uint64(64)
 		header    = api.chain.CurrentHeader()
-		diff      = uint64(0)
-		optimals  = 0
 	)
 	snap, err := api.congress.snapshot(api.chain, header.Number.Uint64(), header.Hash(), nil)
 	if err != nil {
 		return nil, err
 	}
-	var (
-		validators = snap.validators()
-		end        = header.Number.Uint64()
-		start      = end - numBlocks
-	)
-	if numBlocks > end {
-		start = 1
-		numBlocks = end - start
-	}
 	signStatus := make(map[common.Address]int)
-	for _, s := range validators {
+	for _, s := range snap.validators() {
 		signStatus[s] = 0
 	}
-	for n := start; n < end; n++ {
-		h := api.chain.GetHeaderByNumber(n)
-		if h == nil {
-			return nil, fmt.Errorf("missing block %d", n)
-		}
-		if h.Difficulty.Cmp(diffInTurn) == 0 {
-			optimals++
+	// Walk back the blocks preceding the head
+	var optimals uint64
+	if end := header.Number.Uint64(); end > 0 {
+		parent := api.chain.GetHeader(header.ParentHash, end-1)
+		if parent == nil {
+			return nil, fmt.Errorf("missing block %d", end-1)
 		}
-		diff += h.Difficulty.Uint64()
-		sealer, err := api.congress.Author(h)
+		optimals, numBlocks, err = walkTurns(api.chain, parent, numBlocks, func(h *types.Header, inturn bool) error {
+			sealer, err := api.congress.Author(h)
+			if err != nil {
+				return err
+			}
+			signStatus[sealer]++
+			return nil
+		})
 		if err != nil {
 			return nil, err
 		}
-		signStatus[sealer]++
+	} else {
+		numBlocks = 0
 	}
 	return &status{
-		InturnPercent: float64(100*optimals) / float64(numBlocks),
+		InturnPercent: inturnPercent(optimals, numBlocks),
 		SigningStatus: signStatus,
 		NumBlocks:     numBlocks,
 	}, nil
 }
+
+// GetPolicyHistory retrieves all indexed blacklist, whitelist and admin changes
+// of the given address, in chain order. The history is indexed in sections of
+// 256 blocks once a section has 16 confirmations, so the changes of the newest
+// 256+16 blocks at most are not returned yet.
+func (api *API) GetPolicyHistory(address common.Address) ([]*PolicyChange, error) {
+	return ReadPolicyHistory(api.congress.db, address)
+}
+
+// GetRewards retrieves the fee distribution of the blocks sealed by the given
+// validator between the from and to blocks (both inclusive, latest by default).
+func (api *API) GetRewards(validator common.Address, from rpc.BlockNumber, to *rpc.BlockNumber) ([]*RewardRecord, error) {
+	first, last, err := resolveBlockRange(api.chain.CurrentHeader(), from, to)
+	if err != nil {
+		return nil, err
+	}
+	return ReadRewards(api.congress.db, validator, first, last)
+}
+
+// Rewards creates a subscription that fires with the fee distribution of every
+// new block.
+func (api *API) Rewards(ctx context.Context) (*rpc.Subscription, error) {
+	records := make(chan *RewardRecord)
+	recordsSub := api.congress.SubscribeRewards(records)
+	if recordsSub == nil {
+		return &rpc.Subscription{}, errors.New("reward ledger not running")
+	}
+	return rpc.NotifyEvents(ctx, recordsSub, records, nil)
+}
+
+// GetValidatorSetHistory retrieves the changes of the validator set done by the
+// epoch blocks between the from and to blocks (both inclusive, latest by default).
+func (api *API) GetValidatorSetHistory(from rpc.BlockNumber, to *rpc.BlockNumber) ([]*ValidatorSetChange, error) {
+	first, last, err := resolveBlockRange(api.chain.CurrentHeader(), from, to)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		changes = make([]*ValidatorSetChange, 0)
+		epochs  int
+		failure error
+	)
+	err = api.congress.IterateEpochs(api.chain, first, last, func(header *types.Header) bool {
+		if epochs++; epochs > maxValidatorSetEpochs {
+			failure = fmt.Errorf("block range exceeds %d epochs", maxValidatorSetEpochs)
+			return false
+		}
+		change, err := api.congress.validatorSetChange(api.chain, header)
+		if err != nil {
+			failure = err
+			return false
+		}
+		if change != nil {
+			changes = append(changes, change)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if failure != nil {
+		return nil, failure
+	}
+	return changes, nil
+}
+
+// PublicValidatorSetAPI offers the validator set change subscription of the eth
+// namespace.
+type PublicValidatorSetAPI struct {
+	congress *Congress
+}
+
+// ValidatorSetChanges creates a subscription that fires with the added and removed
+// validators of every epoch changing the validator set.
+func (api *PublicValidatorSetAPI) ValidatorSetChanges(ctx context.Context) (*rpc.Subscription, error) {
+	changes := make(chan *ValidatorSetChange)
+	changesSub := api.congress.SubscribeValidatorSetChanges(changes)
+	if changesSub == nil {
+		return &rpc.Subscription{}, errors.New("validator set feed not running")
+	}
+	return rpc.NotifyEvents(ctx, changesSub, changes, nil)
+}
+
+// resolveBlockRange resolves the from and to blocks (both inclusive) of a block
+// range request against the head, to which the latest and pending blocks and a
+// missing to block resolve.
+func resolveBlockRange(head *types.Header, from rpc.BlockNumber, to *rpc.BlockNumber) (uint64, uint64, error) {
+	resolve := func(number rpc.BlockNumber) uint64 {
+		if number < 0 {
+			return head.Number.Uint64()
+		}
+		return uint64(number)
+	}
+	last := head.Number.Uint64()
+	if to != nil {
+		last = resolve(*to)
+	}
+	first := resolve(from)
+	if first > last {
+		return 0, 0, errors.New("invalid block range")
+	}
+	return first, last, nil
+}
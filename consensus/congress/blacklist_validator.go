@@ -1,22 +1,78 @@
 package congress
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 )
 
+// EventCheckRule tells which addresses of an event should be checked against
+// the blacklist. The location of a data or array check is packed into the high
+// bits of the checkIdx the AddressList contract stores (see
+// systemcontract.PackRuleCheckIdx).
 type EventCheckRule struct {
-	EventSig common.Hash
-	Checks   map[int]common.AddressCheckType
+	EventSig    common.Hash
+	Checks      map[int]common.AddressCheckType // topic index => check type
+	DataChecks  map[int]common.AddressCheckType // data word index of a static address => check type
+	ArrayChecks map[int]common.AddressCheckType // data word index of the offset of an address array => check type
+}
+
+// newEventCheckRule creates an empty rule for the given event.
+func newEventCheckRule(sig common.Hash) *EventCheckRule {
+	return &EventCheckRule{
+		EventSig:    sig,
+		Checks:      make(map[int]common.AddressCheckType),
+		DataChecks:  make(map[int]common.AddressCheckType),
+		ArrayChecks: make(map[int]common.AddressCheckType),
+	}
+}
+
+// add adds a check of the given location to the rule.
+func (r *EventCheckRule) add(location common.AddressLocation, idx int, ct common.AddressCheckType) {
+	switch location {
+	case common.LocationTopic:
+		r.Checks[idx] = ct
+	case common.LocationData:
+		r.DataChecks[idx] = ct
+	case common.LocationDataArray:
+		r.ArrayChecks[idx] = ct
+	default:
+		log.Warn("event check rule, unsupported address location", "sig", r.EventSig.String(), "location", location, "checkIdx", idx)
+	}
 }
 
 type blacklistValidator struct {
 	blacks map[common.Address]blacklistDirection
 	rules  map[common.Hash]*EventCheckRule
+
+	hook types.PolicyHook // hook reports every check, only set for tracing
 }
 
-func (b *blacklistValidator) IsAddressDenied(address common.Address, cType common.AddressCheckType) (hit bool) {
+// WithHook implements types.TraceableEvmExtraValidator.
+func (b *blacklistValidator) WithHook(hook types.PolicyHook) types.EvmExtraValidator {
+	return &blacklistValidator{
+		blacks: b.blacks,
+		rules:  b.rules,
+		hook:   hook,
+	}
+}
+
+func (b *blacklistValidator) IsAddressDenied(address common.Address, cType common.AddressCheckType) bool {
+	hit := b.isAddressDenied(address, cType)
+	if b.hook != nil {
+		b.hook(&types.PolicyCheck{
+			Kind:      types.PolicyBlacklist,
+			Address:   address,
+			CheckType: cType,
+			Denied:    hit,
+		})
+	}
+	return hit
+}
+
+func (b *blacklistValidator) isAddressDenied(address common.Address, cType common.AddressCheckType) (hit bool) {
 	d, exist := b.blacks[address]
 	if exist {
 		switch cType {
@@ -39,21 +95,100 @@ func (b *blacklistValidator) IsAddressDenied(address common.Address, cType commo
 }
 
 func (b *blacklistValidator) IsLogDenied(evLog *types.Log) bool {
-	if nil == evLog || len(evLog.Topics) <= 1 {
+	if nil == evLog || len(evLog.Topics) == 0 {
 		return false
 	}
-	if rule, exist := b.rules[evLog.Topics[0]]; exist {
-		for idx, checkType := range rule.Checks {
-			// do a basic check
-			if idx >= len(evLog.Topics) {
-				log.Error("check index in rule out to range", "sig", rule.EventSig.String(), "checkIdx", idx, "topicsLen", len(evLog.Topics))
-				continue
-			}
-			addr := common.BytesToAddress(evLog.Topics[idx].Bytes())
-			if b.IsAddressDenied(addr, checkType) {
+	rule, exist := b.rules[evLog.Topics[0]]
+	if !exist {
+		return false
+	}
+	for idx, checkType := range rule.Checks {
+		// do a basic check
+		if idx >= len(evLog.Topics) {
+			log.Error("check index in rule out to range", "sig", rule.EventSig.String(), "checkIdx", idx, "topicsLen", len(evLog.Topics))
+			continue
+		}
+		addr := common.BytesToAddress(evLog.Topics[idx].Bytes())
+		if b.isLogAddressDenied(rule, addr, checkType, common.LocationTopic, idx, 0) {
+			return true
+		}
+	}
+	for idx, checkType := range rule.DataChecks {
+		word := dataWord(evLog.Data, idx)
+		if word == nil {
+			log.Error("check index in rule out to range", "sig", rule.EventSig.String(), "dataIdx", idx, "dataLen", len(evLog.Data))
+			continue
+		}
+		if b.isLogAddressDenied(rule, common.BytesToAddress(word), checkType, common.LocationData, idx, 0) {
+			return true
+		}
+	}
+	for idx, checkType := range rule.ArrayChecks {
+		addrs, ok := dataAddressArray(evLog.Data, idx)
+		if !ok {
+			log.Error("invalid address array in log data", "sig", rule.EventSig.String(), "dataIdx", idx, "dataLen", len(evLog.Data))
+			continue
+		}
+		for i, addr := range addrs {
+			if b.isLogAddressDenied(rule, addr, checkType, common.LocationDataArray, idx, i) {
 				return true
 			}
 		}
 	}
 	return false
 }
+
+// isLogAddressDenied checks an address taken from a log by the given rule.
+func (b *blacklistValidator) isLogAddressDenied(rule *EventCheckRule, addr common.Address, checkType common.AddressCheckType, location common.AddressLocation, idx, arrayIdx int) bool {
+	hit := b.isAddressDenied(addr, checkType)
+	if b.hook != nil {
+		b.hook(&types.PolicyCheck{
+			Kind:       types.PolicyEventRule,
+			Address:    addr,
+			CheckType:  checkType,
+			Denied:     hit,
+			EventSig:   rule.EventSig,
+			Location:   location,
+			TopicIndex: idx,
+			ArrayIndex: arrayIdx,
+		})
+	}
+	return hit
+}
+
+// dataWord returns the idx-th 32 bytes word of the ABI encoded log data, or nil
+// if it's out of range.
+func dataWord(data []byte, idx int) []byte {
+	if idx < 0 || idx >= len(data)/common.HashLength {
+		return nil
+	}
+	return data[idx*common.HashLength : (idx+1)*common.HashLength]
+}
+
+// dataAddressArray decodes the dynamic address array whose offset is stored at
+// the idx-th word of the ABI encoded log data.
+func dataAddressArray(data []byte, idx int) ([]common.Address, bool) {
+	head := dataWord(data, idx)
+	if head == nil {
+		return nil, false
+	}
+	offset := new(big.Int).SetBytes(head)
+	if !offset.IsUint64() || offset.Uint64()%common.HashLength != 0 {
+		return nil, false
+	}
+	start := int(offset.Uint64() / common.HashLength)
+	lenWord := dataWord(data, start)
+	if lenWord == nil {
+		return nil, false
+	}
+	length := new(big.Int).SetBytes(lenWord)
+	// Every element takes one word, so the length can't exceed the rest of the data
+	if !length.IsUint64() || length.Uint64() > uint64(len(data)/common.HashLength-start-1) {
+		return nil, false
+	}
+	addrs := make([]common.Address, length.Uint64())
+	for i := range addrs {
+		addrs[i] = common.BytesToAddress(dataWord(data, start+1+i))
+	}
+	return addrs, true
+}
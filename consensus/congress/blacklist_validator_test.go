@@ -0,0 +1,92 @@
+package congress
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestBlacklistValidatorHook(t *testing.T) {
+	var (
+		black   = common.HexToAddress("0x01")
+		other   = common.HexToAddress("0x02")
+		sig     = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+		checks  []*types.PolicyCheck
+		checker = &blacklistValidator{
+			blacks: map[common.Address]blacklistDirection{black: DirectionTo},
+			rules: map[common.Hash]*EventCheckRule{
+				sig: {EventSig: sig, Checks: map[int]common.AddressCheckType{2: common.CheckTo}},
+			},
+		}
+	)
+	validator := checker.WithHook(func(check *types.PolicyCheck) {
+		checks = append(checks, check)
+	})
+
+	if validator.IsAddressDenied(black, common.CheckFrom) {
+		t.Fatal("address blacklisted as 'to' denied on 'from' check")
+	}
+	if !validator.IsAddressDenied(black, common.CheckTo) {
+		t.Fatal("address blacklisted as 'to' not denied on 'to' check")
+	}
+	evLog := &types.Log{Topics: []common.Hash{sig, other.Hash(), black.Hash()}}
+	if !validator.IsLogDenied(evLog) {
+		t.Fatal("log transferring to blacklisted address not denied")
+	}
+	if len(checks) != 3 {
+		t.Fatalf("reported checks mismatch: have %d, want 3", len(checks))
+	}
+	if checks[0].Denied || !checks[1].Denied {
+		t.Errorf("address checks reported wrongly: %+v %+v", checks[0], checks[1])
+	}
+	if rule := checks[2]; rule.Kind != types.PolicyEventRule || rule.EventSig != sig || rule.TopicIndex != 2 || rule.Address != black || !rule.Denied {
+		t.Errorf("event rule check reported wrongly: %+v", rule)
+	}
+	// The original validator must stay silent
+	checker.IsAddressDenied(black, common.CheckTo)
+	if len(checks) != 3 {
+		t.Errorf("validator without hook reported a check")
+	}
+}
+
+func TestBlacklistValidatorLogData(t *testing.T) {
+	var (
+		black = common.HexToAddress("0x01")
+		other = common.HexToAddress("0x02")
+		// TransferBatch-like event carrying (address operator, address[] receivers) in data
+		sig       = common.HexToHash("0x01")
+		validator = &blacklistValidator{
+			blacks: map[common.Address]blacklistDirection{black: DirectionTo},
+			rules:  map[common.Hash]*EventCheckRule{sig: newEventCheckRule(sig)},
+		}
+	)
+	validator.rules[sig].add(common.LocationData, 0, common.CheckFrom)
+	validator.rules[sig].add(common.LocationDataArray, 1, common.CheckTo)
+
+	encode := func(operator common.Address, receivers ...common.Address) []byte {
+		data := append(operator.Hash().Bytes(), common.BigToHash(big.NewInt(64)).Bytes()...)
+		data = append(data, common.BigToHash(big.NewInt(int64(len(receivers)))).Bytes()...)
+		for _, r := range receivers {
+			data = append(data, r.Hash().Bytes()...)
+		}
+		return data
+	}
+	tests := []struct {
+		data   []byte
+		denied bool
+	}{
+		{encode(other, other, other), false},
+		{encode(black, other), false}, // blacklisted as 'to' only
+		{encode(other, other, black), true},
+		{encode(other), false},
+		{encode(other, black)[:64], false}, // malformed array is skipped
+	}
+	for i, tt := range tests {
+		evLog := &types.Log{Topics: []common.Hash{sig}, Data: tt.data}
+		if denied := validator.IsLogDenied(evLog); denied != tt.denied {
+			t.Errorf("test %d: denied mismatch: have %v, want %v", i, denied, tt.denied)
+		}
+	}
+}
@@ -34,16 +34,19 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
 	"github.com/ethereum/go-ethereum/consensus/congress/vmcaller"
 	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -53,7 +56,7 @@ import (
 )
 
 const (
-	checkpointInterval = 1024 // Number of blocks after which to save the vote snapshot to the database
+	CheckpointInterval = 1024 // Number of blocks after which to save the vote snapshot to the database
 	inmemorySnapshots  = 128  // Number of recent vote snapshots to keep in memory
 	inmemorySignatures = 4096 // Number of recent block signatures to keep in memory
 
@@ -117,8 +120,9 @@ var (
 	// list of validators different than the one the local node calculated.
 	errMismatchingCheckpointValidators = errors.New("mismatching validator list on checkpoint block")
 
-	// errInvalidMixDigest is returned if a block's mix digest is non-zero.
-	errInvalidMixDigest = errors.New("non-zero mix digest")
+	// errInvalidMixDigest is returned if a block's mix digest is non-zero, apart
+	// from the valid consensus parameters of an epoch block.
+	errInvalidMixDigest = errors.New("invalid mix digest")
 
 	// errInvalidUncleHash is returned if a block contains an non-empty uncle list.
 	errInvalidUncleHash = errors.New("non empty uncle hash")
@@ -170,12 +174,17 @@ type StateFn func(hash common.Hash) (*state.StateDB, error)
 type ValidatorFn func(validator accounts.Account, mimeType string, message []byte) ([]byte, error)
 type SignTxFn func(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
 
-// ecrecover extracts the Ethereum account address from a signed header.
+// ecrecover extracts the Ethereum account address from a signed header, using
+// the signature cache if any.
 func ecrecover(header *types.Header, sigcache *lru.ARCCache) (common.Address, error) {
 	// If the signature's already cached, return that
 	hash := header.Hash()
-	if address, known := sigcache.Get(hash); known {
-		return address.(common.Address), nil
+	if sigcache != nil {
+		if address, known := sigcache.Get(hash); known {
+			signaturesHitMeter.Mark(1)
+			return address.(common.Address), nil
+		}
+		signaturesMissMeter.Mark(1)
 	}
 	// Retrieve the signature from the header extra-data
 	if len(header.Extra) < extraSeal {
@@ -191,7 +200,9 @@ func ecrecover(header *types.Header, sigcache *lru.ARCCache) (common.Address, er
 	var validator common.Address
 	copy(validator[:], crypto.Keccak256(pubkey[1:])[12:])
 
-	sigcache.Add(hash, validator)
+	if sigcache != nil {
+		sigcache.Add(hash, validator)
+	}
 	return validator, nil
 }
 
@@ -217,7 +228,10 @@ type Congress struct {
 	validator common.Address // Ethereum address of the signing key
 	signFn    ValidatorFn    // Validator function to authorize hashes with
 	signTxFn  SignTxFn
-	lock      sync.RWMutex // Protects the validator fields
+	localNode *enode.LocalNode // Local node advertising the validator in its record
+
+	trustedEpoch uint64       // Epoch length governed by the last trusted epoch block
+	lock         sync.RWMutex // Protects the validator fields and the trusted epoch length
 
 	stateFn StateFn // Function to get state by state root
 
@@ -225,6 +239,11 @@ type Congress struct {
 
 	chain consensus.ChainHeaderReader // chain is only for reading parent headers when getting blacklist and rules
 
+	policyIndexer *core.ChainIndexer // policyIndexer records the history of blacklist and whitelist changes
+	rewards       *rewardLedger      // rewards records the fee distribution of every block
+	validatorSets *validatorSetFeed  // validatorSets reports the validator set changes of new epochs
+	blockMetrics  *blockMetrics      // blockMetrics counts the seals and proposals of canonical blocks
+
 	// The fields below are for testing only
 	fakeDiff bool // Skip difficulty verifications
 }
@@ -322,23 +341,11 @@ func (c *Congress) verifyHeader(chain consensus.ChainHeaderReader, header *types
 	if len(header.Extra) < extraVanity+extraSeal {
 		return errMissingSignature
 	}
-	// check extra data
-	isEpoch := number%c.config.Epoch == 0
-
-	// Ensure that the extra-data contains a validator list on checkpoint, but none otherwise
-	validatorsBytes := len(header.Extra) - extraVanity - extraSeal
-	if !isEpoch && validatorsBytes != 0 {
-		return errExtraValidators
-	}
-	// Ensure that the validator bytes length is valid
-	if isEpoch && validatorsBytes%common.AddressLength != 0 {
+	// Ensure that the validator bytes length is valid, whether the block is an
+	// epoch block depends on the consensus parameters checked with its parent
+	if (len(header.Extra)-extraVanity-extraSeal)%common.AddressLength != 0 {
 		return errExtraValidators
 	}
-
-	// Ensure that the mix digest is zero as we don't have fork protection currently
-	if header.MixDigest != (common.Hash{}) {
-		return errInvalidMixDigest
-	}
 	// Ensure that the block doesn't contain any uncles which are meaningless in PoA
 	if header.UncleHash != uncleHash {
 		return errInvalidUncleHash
@@ -381,9 +388,40 @@ func (c *Congress) verifyCascadingFields(chain consensus.ChainHeaderReader, head
 		return consensus.ErrUnknownAncestor
 	}
 
-	if parent.Time+c.config.Period > header.Time {
+	isEpoch, cp, err := c.isEpoch(chain, header, parents)
+	if err != nil {
+		return err
+	}
+	// Ensure that the extra-data contains a validator list on checkpoint, but none
+	// otherwise, capped by the governed maximum after the governance fork
+	governed := c.config.IsGovernance(header.Number)
+	validatorsBytes := len(header.Extra) - extraVanity - extraSeal
+	if !isEpoch && validatorsBytes != 0 {
+		return errExtraValidators
+	}
+	if isEpoch && (validatorsBytes == 0 || (governed && uint64(validatorsBytes/common.AddressLength) > cp.MaxValidators)) {
+		return errInvalidValidatorsLength
+	}
+	// Ensure that the mix digest is zero, apart from the consensus parameters of
+	// epoch blocks after the governance fork
+	if (!isEpoch || !governed) && header.MixDigest != (common.Hash{}) {
+		return errInvalidMixDigest
+	}
+	if isEpoch && governed {
+		if _, err := decodeParams(header.MixDigest); err != nil {
+			return err
+		}
+	}
+
+	if parent.Time+cp.Period > header.Time {
 		return ErrInvalidTimestamp
 	}
+	// Ensure that the gas limit moves towards the governed target if any
+	if cp.GasLimit != 0 {
+		if want := core.CalcGasLimit(parent.GasLimit, cp.GasLimit); header.GasLimit != want {
+			return fmt.Errorf("invalid gasLimit: have %d, want %d", header.GasLimit, want)
+		}
+	}
 
 	// Verify that the gasUsed is <= gasLimit
 	if header.GasUsed > header.GasLimit {
@@ -416,11 +454,13 @@ func (c *Congress) snapshot(chain consensus.ChainHeaderReader, number uint64, ha
 	for snap == nil {
 		// If an in-memory snapshot was found, use that
 		if s, ok := c.recents.Get(hash); ok {
+			recentsHitMeter.Mark(1)
 			snap = s.(*Snapshot)
 			break
 		}
+		recentsMissMeter.Mark(1)
 		// If an on-disk checkpoint snapshot can be found, use that
-		if number%checkpointInterval == 0 {
+		if number%CheckpointInterval == 0 {
 			if s, err := loadSnapshot(c.config, c.signatures, c.db, hash); err == nil {
 				log.Trace("Loaded voting snapshot from disk", "number", number, "hash", hash)
 				snap = s
@@ -431,20 +471,21 @@ func (c *Congress) snapshot(chain consensus.ChainHeaderReader, number uint64, ha
 		// at a checkpoint block without a parent (light client CHT), or we have piled
 		// up more headers than allowed to be reorged (chain reinit from a freezer),
 		// consider the checkpoint trusted and snapshot it.
-		if number == 0 || (number%c.config.Epoch == 0 && (len(headers) > params.FullImmutabilityThreshold || chain.GetHeaderByNumber(number-1) == nil)) {
+		//
+		// The epoch length may be governed, so epoch blocks are told by the validator
+		// list in their extra-data.
+		if number == 0 || len(headers) > params.FullImmutabilityThreshold || chain.GetHeaderByNumber(number-1) == nil {
 			checkpoint := chain.GetHeaderByNumber(number)
-			if checkpoint != nil {
-				hash := checkpoint.Hash()
-
-				validators := make([]common.Address, (len(checkpoint.Extra)-extraVanity-extraSeal)/common.AddressLength)
-				for i := 0; i < len(validators); i++ {
-					copy(validators[i][:], checkpoint.Extra[extraVanity+i*common.AddressLength:])
+			if checkpoint != nil && IsEpochHeader(checkpoint) {
+				s, err := c.epochSnapshot(checkpoint)
+				if err != nil {
+					return nil, err
 				}
-				snap = newSnapshot(c.config, c.signatures, number, hash, validators)
+				snap = s
 				if err := snap.store(c.db); err != nil {
 					return nil, err
 				}
-				log.Info("Stored checkpoint snapshot to disk", "number", number, "hash", hash)
+				log.Info("Stored checkpoint snapshot to disk", "number", number, "hash", snap.Hash)
 				break
 			}
 		}
@@ -478,7 +519,7 @@ func (c *Congress) snapshot(chain consensus.ChainHeaderReader, number uint64, ha
 	c.recents.Add(snap.Hash, snap)
 
 	// If we've generated a new checkpoint snapshot, save to disk
-	if snap.Number%checkpointInterval == 0 && len(headers) > 0 {
+	if snap.Number%CheckpointInterval == 0 && len(headers) > 0 {
 		if err = snap.store(c.db); err != nil {
 			return nil, err
 		}
@@ -576,8 +617,12 @@ func (c *Congress) Prepare(chain consensus.ChainHeaderReader, header *types.Head
 	}
 	header.Extra = header.Extra[:extraVanity]
 
-	if number%c.config.Epoch == 0 {
-		newSortedValidators, err := c.getTopValidators(chain, header)
+	// Mix digest is reserved for the consensus parameters of epoch blocks
+	header.MixDigest = common.Hash{}
+
+	cp := snap.params()
+	if number%cp.Epoch == 0 {
+		newSortedValidators, err := c.getTopValidators(chain, header, cp)
 		if err != nil {
 			return err
 		}
@@ -585,21 +630,25 @@ func (c *Congress) Prepare(chain consensus.ChainHeaderReader, header *types.Head
 		for _, validator := range newSortedValidators {
 			header.Extra = append(header.Extra, validator.Bytes()...)
 		}
+		if header.MixDigest, err = c.nextParams(chain, header); err != nil {
+			return err
+		}
 	}
 	header.Extra = append(header.Extra, make([]byte, extraSeal)...)
 
-	// Mix digest is reserved for now, set to empty
-	header.MixDigest = common.Hash{}
-
 	// Ensure the timestamp has the correct delay
 	parent := chain.GetHeader(header.ParentHash, number-1)
 	if parent == nil {
 		return consensus.ErrUnknownAncestor
 	}
-	header.Time = parent.Time + c.config.Period
+	header.Time = parent.Time + cp.Period
 	if header.Time < uint64(time.Now().Unix()) {
 		header.Time = uint64(time.Now().Unix())
 	}
+	// Move the gas limit towards the governed target if any
+	if cp.GasLimit != 0 {
+		header.GasLimit = core.CalcGasLimit(parent.GasLimit, cp.GasLimit)
+	}
 	return nil
 }
 
@@ -608,14 +657,14 @@ func (c *Congress) Prepare(chain consensus.ChainHeaderReader, header *types.Head
 func (c *Congress) Finalize(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs *[]*types.Transaction, uncles []*types.Header, receipts *[]*types.Receipt, systemTxs []*types.Transaction) error {
 	// Initialize all system contracts at block 1.
 	if header.Number.Cmp(common.Big1) == 0 {
-		if err := c.initializeSystemContracts(chain, header, state); err != nil {
+		if err := c.initializeSystemContracts(chain, header, state, nil); err != nil {
 			log.Error("Initialize system contracts failed", "err", err)
 			return err
 		}
 	}
 
 	if header.Difficulty.Cmp(diffInTurn) != 0 {
-		if err := c.tryPunishValidator(chain, header, state); err != nil {
+		if err := c.tryPunishValidator(chain, header, state, nil); err != nil {
 			return err
 		}
 	}
@@ -631,18 +680,31 @@ func (c *Congress) Finalize(chain consensus.ChainHeaderReader, header *types.Hea
 	}
 
 	// execute block reward tx.
+	var reward *RewardRecord
 	if len(*txs) > 0 {
-		if err := c.trySendBlockReward(chain, header, state); err != nil {
+		var err error
+		if reward, err = c.trySendBlockReward(chain, header, state, nil); err != nil {
 			return err
 		}
 	}
 
 	// do epoch thing at the end, because it will update active validators
-	if header.Number.Uint64()%c.config.Epoch == 0 {
-		newValidators, err := c.doSomethingAtEpoch(chain, header, state)
+	isEpoch, cp, err := c.isEpoch(chain, header, nil)
+	if err != nil {
+		return err
+	}
+	if isEpoch {
+		newValidators, err := c.doSomethingAtEpoch(chain, header, cp, state, nil)
+		if err != nil {
+			return err
+		}
+		digest, err := c.nextParams(chain, header)
 		if err != nil {
 			return err
 		}
+		if header.MixDigest != digest {
+			return errInvalidParams
+		}
 
 		validatorsBytes := make([]byte, len(newValidators)*common.AddressLength)
 		for i, validator := range newValidators {
@@ -687,7 +749,7 @@ func (c *Congress) Finalize(chain consensus.ChainHeaderReader, header *types.Hea
 		}
 		// Finish all proposal
 		for i := uint32(0); i < proposalCount; i++ {
-			err = c.finishProposalById(chain, header, state, pIds[i])
+			err = c.finishProposalById(chain, header, state, pIds[i], nil)
 			if err != nil {
 				return err
 			}
@@ -697,6 +759,7 @@ func (c *Congress) Finalize(chain consensus.ChainHeaderReader, header *types.Hea
 	// No block rewards in PoA, so the state remains as is and uncles are dropped
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
 	header.UncleHash = types.CalcUncleHash(nil)
+	c.keepReward(header, reward)
 
 	return nil
 }
@@ -711,28 +774,33 @@ func (c *Congress) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header
 	}()
 	// Initialize all system contracts at block 1.
 	if header.Number.Cmp(common.Big1) == 0 {
-		if err := c.initializeSystemContracts(chain, header, state); err != nil {
+		if err := c.initializeSystemContracts(chain, header, state, nil); err != nil {
 			panic(err)
 		}
 	}
 
 	// punish validator if necessary
 	if header.Difficulty.Cmp(diffInTurn) != 0 {
-		if err := c.tryPunishValidator(chain, header, state); err != nil {
+		if err := c.tryPunishValidator(chain, header, state, nil); err != nil {
 			panic(err)
 		}
 	}
 
 	// deposit block reward if any tx exists.
+	var reward *RewardRecord
 	if len(txs) > 0 {
-		if err := c.trySendBlockReward(chain, header, state); err != nil {
+		if reward, err = c.trySendBlockReward(chain, header, state, nil); err != nil {
 			panic(err)
 		}
 	}
 
 	// do epoch thing at the end, because it will update active validators
-	if header.Number.Uint64()%c.config.Epoch == 0 {
-		if _, err := c.doSomethingAtEpoch(chain, header, state); err != nil {
+	isEpoch, cp, err := c.isEpoch(chain, header, nil)
+	if err != nil {
+		panic(err)
+	}
+	if isEpoch {
+		if _, err := c.doSomethingAtEpoch(chain, header, cp, state, nil); err != nil {
 			panic(err)
 		}
 	}
@@ -770,7 +838,7 @@ func (c *Congress) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header
 		}
 		// Finish all proposal
 		for i := uint32(0); i < proposalCount; i++ {
-			err = c.finishProposalById(chain, header, state, pIds[i])
+			err = c.finishProposalById(chain, header, state, pIds[i], nil)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -780,15 +848,16 @@ func (c *Congress) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header
 	// No block rewards in PoA, so the state remains as is and uncles are dropped
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
 	header.UncleHash = types.CalcUncleHash(nil)
+	c.keepReward(header, reward)
 
 	// Assemble and return the final block for sealing
 	return types.NewBlock(header, txs, nil, receipts, new(trie.Trie)), receipts, nil
 }
 
-func (c *Congress) trySendBlockReward(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB) error {
+func (c *Congress) trySendBlockReward(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, tracer consensus.SysCallTracer) (*RewardRecord, error) {
 	fee := state.GetBalance(consensus.FeeRecoder)
 	if fee.Cmp(common.Big0) <= 0 {
-		return nil
+		return nil, nil
 	}
 
 	// Miner will send tx to deposit block fees to contract, add to his balance first.
@@ -800,37 +869,47 @@ func (c *Congress) trySendBlockReward(chain consensus.ChainHeaderReader, header
 	data, err := c.abi[systemcontract.ValidatorsContractName].Pack(method)
 	if err != nil {
 		log.Error("Can't pack data for distributeBlockReward", "err", err)
-		return err
+		return nil, err
+	}
+
+	// Measure the distribution for the reward ledger, failures are not fatal
+	var (
+		slots  *rewardSlots
+		before *rewardBalances
+	)
+	if c.rewards != nil {
+		if slots, err = c.rewardSlots(header, state); err != nil {
+			log.Warn("Failed to locate reward balances", "number", header.Number, "err", err)
+		} else {
+			before = slots.read(state)
+		}
 	}
 
 	nonce := state.GetNonce(header.Coinbase)
 	msg := vmcaller.NewLegacyMessage(header.Coinbase, systemcontract.GetValidatorAddr(header.Number, c.chainConfig), nonce, fee, math.MaxUint64, new(big.Int), data, true)
 
-	if _, err := vmcaller.ExecuteMsg(msg, state, header, newChainContext(chain, c), c.chainConfig); err != nil {
-		return err
+	if _, err := vmcaller.ExecuteTracedMsg(method, tracer, msg, state, header, newChainContext(chain, c), c.chainConfig); err != nil {
+		return nil, err
 	}
 
-	return nil
+	if slots == nil {
+		return nil, nil
+	}
+	record, err := newRewardRecord(header, fee, slots.votePool, before, slots.read(state))
+	if err != nil {
+		log.Warn("Failed to measure block reward", "number", header.Number, "err", err)
+	}
+	return record, nil
 }
 
-func (c *Congress) tryPunishValidator(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB) error {
+func (c *Congress) tryPunishValidator(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, tracer consensus.SysCallTracer) error {
 	number := header.Number.Uint64()
 	snap, err := c.snapshot(chain, number-1, header.ParentHash, nil)
 	if err != nil {
 		return err
 	}
-	validators := snap.validators()
-	outTurnValidator := validators[number%uint64(len(validators))]
-	// check sigend recently or not
-	signedRecently := false
-	for _, recent := range snap.Recents {
-		if recent == outTurnValidator {
-			signedRecently = true
-			break
-		}
-	}
-	if !signedRecently {
-		if err := c.punishValidator(outTurnValidator, chain, header, state); err != nil {
+	if missed, ok := snap.missedValidator(number); ok {
+		if err := c.punishValidator(missed, chain, header, state, tracer); err != nil {
 			return err
 		}
 	}
@@ -838,18 +917,23 @@ func (c *Congress) tryPunishValidator(chain consensus.ChainHeaderReader, header
 	return nil
 }
 
-func (c *Congress) doSomethingAtEpoch(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB) ([]common.Address, error) {
-	newSortedValidators, err := c.getTopValidators(chain, header)
+// doSomethingAtEpoch updates the validator set of an epoch block, with the consensus
+// parameters in effect for the block (the ones of its parent snapshot).
+func (c *Congress) doSomethingAtEpoch(chain consensus.ChainHeaderReader, header *types.Header, cp *Params, state *state.StateDB, tracer consensus.SysCallTracer) ([]common.Address, error) {
+	// the vote pools may change with the validator set
+	c.dropRewardSlots()
+
+	newSortedValidators, err := c.getTopValidators(chain, header, cp)
 	if err != nil {
 		return []common.Address{}, err
 	}
 
 	// update contract new validators if new set exists
-	if err := c.updateValidators(newSortedValidators, chain, header, state); err != nil {
+	if err := c.updateValidators(newSortedValidators, cp.Epoch, chain, header, state, tracer); err != nil {
 		return []common.Address{}, err
 	}
 	//  decrease validator missed blocks counter at epoch
-	if err := c.decreaseMissedBlocksCounter(chain, header, state); err != nil {
+	if err := c.decreaseMissedBlocksCounter(chain, header, state, tracer); err != nil {
 		return []common.Address{}, err
 	}
 
@@ -857,7 +941,7 @@ func (c *Congress) doSomethingAtEpoch(chain consensus.ChainHeaderReader, header
 }
 
 // initializeSystemContracts initializes all genesis system contracts.
-func (c *Congress) initializeSystemContracts(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB) error {
+func (c *Congress) initializeSystemContracts(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, tracer consensus.SysCallTracer) error {
 	snap, err := c.snapshot(chain, 0, header.ParentHash, nil)
 	if err != nil {
 		return err
@@ -909,7 +993,7 @@ func (c *Congress) initializeSystemContracts(chain consensus.ChainHeaderReader,
 		nonce := state.GetNonce(header.Coinbase)
 		msg := vmcaller.NewLegacyMessage(header.Coinbase, &contract.addr, nonce, new(big.Int), math.MaxUint64, new(big.Int), data, true)
 
-		if _, err := vmcaller.ExecuteMsg(msg, state, header, newChainContext(chain, c), c.chainConfig); err != nil {
+		if _, err := vmcaller.ExecuteTracedMsg("initializeSystemContracts", tracer, msg, state, header, newChainContext(chain, c), c.chainConfig); err != nil {
 			return err
 		}
 	}
@@ -918,7 +1002,10 @@ func (c *Congress) initializeSystemContracts(chain consensus.ChainHeaderReader,
 }
 
 // call this at epoch block to get top validators based on the state of epoch block - 1
-func (c *Congress) getTopValidators(chain consensus.ChainHeaderReader, header *types.Header) ([]common.Address, error) {
+// getTopValidators returns the validators of the given epoch block in ascending
+// order. After the governance fork, the top list of the contract is capped by the
+// governed maximum in effect for the block.
+func (c *Congress) getTopValidators(chain consensus.ChainHeaderReader, header *types.Header, cp *Params) ([]common.Address, error) {
 	parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
 	if parent == nil {
 		return []common.Address{}, consensus.ErrUnknownAncestor
@@ -928,41 +1015,27 @@ func (c *Congress) getTopValidators(chain consensus.ChainHeaderReader, header *t
 		return []common.Address{}, err
 	}
 
-	method := "getTopValidators"
-	data, err := c.abi[systemcontract.ValidatorsContractName].Pack(method)
-	if err != nil {
-		log.Error("Can't pack data for getTopValidators", "error", err)
-		return []common.Address{}, err
-	}
-
-	msg := vmcaller.NewLegacyMessage(header.Coinbase, systemcontract.GetValidatorAddr(parent.Number, c.chainConfig), 0, new(big.Int), math.MaxUint64, new(big.Int), data, false)
-
 	// use parent
-	result, err := vmcaller.ExecuteMsg(msg, statedb, parent, newChainContext(chain, c), c.chainConfig)
+	contract, err := c.validatorsContract(parent, statedb, newChainContext(chain, c))
 	if err != nil {
 		return []common.Address{}, err
 	}
-
-	// unpack data
-	ret, err := c.abi[systemcontract.ValidatorsContractName].Unpack(method, result)
+	validators, err := contract.GetTopValidators(nil)
 	if err != nil {
 		return []common.Address{}, err
 	}
-	if len(ret) != 1 {
-		return []common.Address{}, errors.New("Invalid params length")
-	}
-	validators, ok := ret[0].([]common.Address)
-	if !ok {
-		return []common.Address{}, errors.New("Invalid validators format")
+	// The top list is ranked by the contract, keep the best ones
+	if c.config.IsGovernance(header.Number) && uint64(len(validators)) > cp.MaxValidators {
+		validators = validators[:cp.MaxValidators]
 	}
 	sort.Sort(validatorsAscending(validators))
 	return validators, err
 }
 
-func (c *Congress) updateValidators(vals []common.Address, chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB) error {
+func (c *Congress) updateValidators(vals []common.Address, epoch uint64, chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, tracer consensus.SysCallTracer) error {
 	// method
 	method := "updateActiveValidatorSet"
-	data, err := c.abi[systemcontract.ValidatorsContractName].Pack(method, vals, new(big.Int).SetUint64(c.config.Epoch))
+	data, err := c.abi[systemcontract.ValidatorsContractName].Pack(method, vals, new(big.Int).SetUint64(epoch))
 	if err != nil {
 		log.Error("Can't pack data for updateActiveValidatorSet", "error", err)
 		return err
@@ -971,7 +1044,7 @@ func (c *Congress) updateValidators(vals []common.Address, chain consensus.Chain
 	// call contract
 	nonce := state.GetNonce(header.Coinbase)
 	msg := vmcaller.NewLegacyMessage(header.Coinbase, systemcontract.GetValidatorAddr(header.Number, c.chainConfig), nonce, new(big.Int), math.MaxUint64, new(big.Int), data, true)
-	if _, err := vmcaller.ExecuteMsg(msg, state, header, newChainContext(chain, c), c.chainConfig); err != nil {
+	if _, err := vmcaller.ExecuteTracedMsg(method, tracer, msg, state, header, newChainContext(chain, c), c.chainConfig); err != nil {
 		log.Error("Can't update validators to contract", "err", err)
 		return err
 	}
@@ -979,7 +1052,7 @@ func (c *Congress) updateValidators(vals []common.Address, chain consensus.Chain
 	return nil
 }
 
-func (c *Congress) punishValidator(val common.Address, chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB) error {
+func (c *Congress) punishValidator(val common.Address, chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, tracer consensus.SysCallTracer) error {
 	// method
 	method := "punish"
 	data, err := c.abi[systemcontract.PunishContractName].Pack(method, val)
@@ -991,7 +1064,7 @@ func (c *Congress) punishValidator(val common.Address, chain consensus.ChainHead
 	// call contract
 	nonce := state.GetNonce(header.Coinbase)
 	msg := vmcaller.NewLegacyMessage(header.Coinbase, systemcontract.GetPunishAddr(header.Number, c.chainConfig), nonce, new(big.Int), math.MaxUint64, new(big.Int), data, true)
-	if _, err := vmcaller.ExecuteMsg(msg, state, header, newChainContext(chain, c), c.chainConfig); err != nil {
+	if _, err := vmcaller.ExecuteTracedMsg(method, tracer, msg, state, header, newChainContext(chain, c), c.chainConfig); err != nil {
 		log.Error("Can't punish validator", "err", err)
 		return err
 	}
@@ -999,10 +1072,10 @@ func (c *Congress) punishValidator(val common.Address, chain consensus.ChainHead
 	return nil
 }
 
-func (c *Congress) decreaseMissedBlocksCounter(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB) error {
+func (c *Congress) decreaseMissedBlocksCounter(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, tracer consensus.SysCallTracer) error {
 	// method
 	method := "decreaseMissedBlocksCounter"
-	data, err := c.abi[systemcontract.PunishContractName].Pack(method, new(big.Int).SetUint64(c.config.Epoch))
+	data, err := c.abi[systemcontract.PunishContractName].Pack(method, new(big.Int).SetUint64(c.epochParams(header).Epoch))
 	if err != nil {
 		log.Error("Can't pack data for decreaseMissedBlocksCounter", "error", err)
 		return err
@@ -1011,7 +1084,7 @@ func (c *Congress) decreaseMissedBlocksCounter(chain consensus.ChainHeaderReader
 	// call contract
 	nonce := state.GetNonce(header.Coinbase)
 	msg := vmcaller.NewLegacyMessage(header.Coinbase, systemcontract.GetPunishAddr(header.Number, c.chainConfig), nonce, new(big.Int), math.MaxUint64, new(big.Int), data, true)
-	if _, err := vmcaller.ExecuteMsg(msg, state, header, newChainContext(chain, c), c.chainConfig); err != nil {
+	if _, err := vmcaller.ExecuteTracedMsg(method, tracer, msg, state, header, newChainContext(chain, c), c.chainConfig); err != nil {
 		log.Error("Can't decrease missed blocks counter for validator", "err", err)
 		return err
 	}
@@ -1020,14 +1093,15 @@ func (c *Congress) decreaseMissedBlocksCounter(chain consensus.ChainHeaderReader
 }
 
 // Authorize injects a private key into the consensus engine to mint new blocks
-// with.
+// with, and advertises the validator in the record of the local node.
 func (c *Congress) Authorize(validator common.Address, signFn ValidatorFn, signTxFn SignTxFn) {
 	c.lock.Lock()
-	defer c.lock.Unlock()
-
 	c.validator = validator
 	c.signFn = signFn
 	c.signTxFn = signTxFn
+	c.lock.Unlock()
+
+	c.updateENR()
 }
 
 // Seal implements consensus.Engine, attempting to create a sealed block using
@@ -1040,21 +1114,23 @@ func (c *Congress) Seal(chain consensus.ChainHeaderReader, block *types.Block, r
 	if number == 0 {
 		return errUnknownBlock
 	}
-	// For 0-period chains, refuse to seal empty blocks (no reward but would spin sealing)
-	if c.config.Period == 0 && len(block.Transactions()) == 0 {
-		log.Info("Sealing paused, waiting for transactions")
-		return nil
-	}
 	// Don't hold the val fields for the entire sealing procedure
 	c.lock.RLock()
 	val, signFn := c.validator, c.signFn
 	c.lock.RUnlock()
 
-	// Bail out if we're unauthorized to sign a block
 	snap, err := c.snapshot(chain, number-1, header.ParentHash, nil)
 	if err != nil {
 		return err
 	}
+	cp := snap.params()
+
+	// For 0-period chains, refuse to seal empty blocks (no reward but would spin sealing)
+	if cp.Period == 0 && len(block.Transactions()) == 0 {
+		log.Info("Sealing paused, waiting for transactions")
+		return nil
+	}
+	// Bail out if we're unauthorized to sign a block
 	if _, authorized := snap.Validators[val]; !authorized {
 		return errUnauthorizedValidator
 	}
@@ -1064,6 +1140,7 @@ func (c *Congress) Seal(chain consensus.ChainHeaderReader, block *types.Block, r
 			// Validator is among recents, only wait if the current block doesn't shift it out
 			if limit := uint64(len(snap.Validators)/2 + 1); number < limit || seen > number-limit {
 				log.Info("Signed recently, must wait for others")
+				sealRecentlyMeter.Mark(1)
 				return nil
 			}
 		}
@@ -1073,8 +1150,10 @@ func (c *Congress) Seal(chain consensus.ChainHeaderReader, block *types.Block, r
 	delay := time.Unix(int64(header.Time), 0).Sub(time.Now()) // nolint: gosimple
 	if header.Difficulty.Cmp(diffNoTurn) == 0 {
 		// It's not our turn explicitly to sign, delay it a bit
-		wiggle := time.Duration(len(snap.Validators)/2+1) * wiggleTime
-		delay += time.Duration(rand.Int63n(int64(wiggle)))
+		wiggle := time.Duration(len(snap.Validators)/2+1) * cp.WiggleTime
+		wiggleDelay := time.Duration(rand.Int63n(int64(wiggle)))
+		delay += wiggleDelay
+		sealWiggleTimer.Update(wiggleDelay)
 
 		log.Trace("Out-of-turn signing requested", "wiggle", common.PrettyDuration(wiggle))
 	}
@@ -1122,13 +1201,39 @@ func calcDifficulty(snap *Snapshot, validator common.Address) *big.Int {
 	return new(big.Int).Set(diffNoTurn)
 }
 
+// IsInTurn tells whether the given header was sealed by the in-turn validator,
+// by its difficulty.
+func IsInTurn(header *types.Header) bool {
+	return header.Difficulty.Cmp(diffInTurn) == 0
+}
+
 // SealHash returns the hash of a block prior to it being sealed.
 func (c *Congress) SealHash(header *types.Header) common.Hash {
 	return SealHash(header)
 }
 
-// Close implements consensus.Engine. It's a noop for congress as there are no background threads.
+// StartPolicyIndexer starts indexing the history of blacklist, whitelist and event
+// check rule changes of the given chain.
+func (c *Congress) StartPolicyIndexer(chain core.ChainIndexerChain) {
+	c.policyIndexer = NewPolicyIndexer(c.db, c.chainConfig)
+	c.policyIndexer.Start(chain)
+}
+
+// Close implements consensus.Engine, terminating the policy indexer, the reward
+// ledger and the validator set feed if they're running.
 func (c *Congress) Close() error {
+	if c.rewards != nil {
+		c.rewards.close()
+	}
+	if c.validatorSets != nil {
+		c.validatorSets.close()
+	}
+	if c.blockMetrics != nil {
+		c.blockMetrics.close()
+	}
+	if c.policyIndexer != nil {
+		return c.policyIndexer.Close()
+	}
 	return nil
 }
 
@@ -1140,6 +1245,11 @@ func (c *Congress) APIs(chain consensus.ChainHeaderReader) []rpc.API {
 		Version:   "1.0",
 		Service:   &API{chain: chain, congress: c},
 		Public:    false,
+	}, {
+		Namespace: "eth",
+		Version:   "1.0",
+		Service:   &PublicValidatorSetAPI{congress: c},
+		Public:    true,
 	}}
 }
 
@@ -1204,7 +1314,7 @@ func (c *Congress) IsSysTransaction(sender common.Address, tx *types.Transaction
 	}
 
 	to := tx.To()
-	if sender == header.Coinbase && *to == systemcontract.SysGovToAddr && tx.GasPrice().Sign() == 0 {
+	if isProposalTransaction(sender, tx, header) {
 		return true, nil
 	}
 	// Make sure the miner can NOT call the system contract through a normal transaction.
@@ -1214,6 +1324,13 @@ func (c *Congress) IsSysTransaction(sender common.Address, tx *types.Transaction
 	return false, nil
 }
 
+// isProposalTransaction checks whether a transaction is the system-transaction
+// executing a governance proposal: one sent by the block's coinbase to
+// SysGovToAddr without gas price.
+func isProposalTransaction(sender common.Address, tx *types.Transaction, header *types.Header) bool {
+	return tx.To() != nil && *tx.To() == systemcontract.SysGovToAddr && sender == header.Coinbase && tx.GasPrice().Sign() == 0
+}
+
 // CanCreate determines where a given address can create a new contract.
 //
 // This will queries the system Developers contract, by DIRECTLY to get the target slot value of the contract,
@@ -1272,6 +1389,42 @@ func (c *Congress) CanTransferByWhitelist(state consensus.StateReader, addr comm
 // ValidateTx do a consensus-related validation on the given transaction at the given header and state.
 // the parentState must be the state of the header's parent block.
 func (c *Congress) ValidateTx(sender common.Address, tx *types.Transaction, header *types.Header, parentState *state.StateDB) error {
+	return c.validateTxPolicy(tx.Hash(), sender, tx.To(), tx.Value(), tx.Data(), header, parentState, nil)
+}
+
+// PolicyUpdatedNumber returns the number of the block which updated the blacklist
+// last at the given state, which lets the txpool evict the transactions denied
+// by a blacklist change.
+func (c *Congress) PolicyUpdatedNumber(state *state.StateDB) uint64 {
+	return lastBlacklistUpdatedNumber(state)
+}
+
+// MaxReorgDepth returns the number of validators at the given block as the
+// default maximum depth of a chain reorganisation. A deeper one replaces a whole
+// round of in-turn blocks, which honest validators don't produce.
+func (c *Congress) MaxReorgDepth(chain consensus.ChainHeaderReader, header *types.Header) uint64 {
+	snap, err := c.snapshot(chain, header.Number.Uint64(), header.Hash(), nil)
+	if err != nil {
+		log.Warn("Failed to get validators for max reorg depth", "number", header.Number, "err", err)
+		return defaultParams(c.config).MaxValidators
+	}
+	return uint64(len(snap.Validators))
+}
+
+// TraceTxPolicy runs the same policy checks as ValidateTx against the given message
+// fields, and reports every check to the hook.
+func (c *Congress) TraceTxPolicy(sender common.Address, to *common.Address, value *big.Int, data []byte, header *types.Header, parentState *state.StateDB, hook types.PolicyHook) error {
+	return c.validateTxPolicy(common.Hash{}, sender, to, value, data, header, parentState, hook)
+}
+
+// validateTxPolicy checks the blacklist and the developer whitelists for a
+// transaction, the hash of which is only used for logging (empty for messages).
+func (c *Congress) validateTxPolicy(txHash common.Hash, sender common.Address, to *common.Address, value *big.Int, data []byte, header *types.Header, parentState *state.StateDB, hook types.PolicyHook) error {
+	report := func(kind types.PolicyCheckKind, addr common.Address, cType common.AddressCheckType, denied bool) {
+		if hook != nil {
+			hook(&types.PolicyCheck{Kind: kind, Address: addr, CheckType: cType, Denied: denied})
+		}
+	}
 	// Must use the parent state for current validation,
 	// so we must starting the validation after redCoastBlock
 
@@ -1280,27 +1433,37 @@ func (c *Congress) ValidateTx(sender common.Address, tx *types.Transaction, head
 	if err != nil {
 		return err
 	}
-	if d, exist := m[sender]; exist && (d != DirectionTo) {
-		log.Trace("Hit blacklist", "tx", tx.Hash().String(), "addr", sender.String(), "direction", d)
+	d, exist := m[sender]
+	denied := exist && (d != DirectionTo)
+	report(types.PolicyBlacklist, sender, common.CheckFrom, denied)
+	if denied {
+		log.Trace("Hit blacklist", "tx", txHash.String(), "addr", sender.String(), "direction", d)
 		return types.ErrAddressDenied
 	}
-	if to := tx.To(); to != nil {
-		if d, exist := m[*to]; exist && (d != DirectionFrom) {
-			log.Trace("Hit blacklist", "tx", tx.Hash().String(), "addr", to.String(), "direction", d)
+	if to != nil {
+		d, exist := m[*to]
+		denied := exist && (d != DirectionFrom)
+		report(types.PolicyBlacklist, *to, common.CheckTo, denied)
+		if denied {
+			log.Trace("Hit blacklist", "tx", txHash.String(), "addr", to.String(), "direction", d)
 			return types.ErrAddressDenied
 		}
 	}
 
 	// yqq, 2022-08-21
 	// Since our tokens cannot be directly used to purchase NFT, we forbid non-B-End users to make ordinary transaction 
-	if tx.Value().Sign() > 0 {
-		if can := c.CanTransferByWhitelist(parentState, sender, header.Number); !can {
+	if value.Sign() > 0 {
+		can := c.CanTransferByWhitelist(parentState, sender, header.Number)
+		report(types.PolicyTransferWhitelist, sender, common.CheckFrom, !can)
+		if !can {
 			return types.ErrUnauthorizedTransferTx
 		}
 	}
 
-	if  tx.To() == nil && tx.Data() != nil {
-		if can := c.CanCreate(parentState, sender, header.Number); !can {
+	if  to == nil && data != nil {
+		can := c.CanCreate(parentState, sender, header.Number)
+		report(types.PolicyCreateWhitelist, sender, common.CheckFrom, !can)
+		if !can {
 			return types.ErrUnauthorizedCreateTx
 		}	
 	}
@@ -1342,25 +1505,23 @@ func (c *Congress) getBlacklist(header *types.Header, parentState *state.StateDB
 	}
 
 	// can't get blacklist from cache, try to call the contract
-	alABI := c.abi[systemcontract.AddressListContractName]
-	get := func(method string) ([]common.Address, error) {
-		ret, err := c.commonCallContract(header, parentState, alABI, systemcontract.AddressListContractAddr, method, 1)
+	contract, err := c.addressListContract(header, parentState)
+	if err != nil {
+		return nil, err
+	}
+	get := func(method string, call func(*bind.CallOpts) ([]common.Address, error)) ([]common.Address, error) {
+		blacks, err := call(nil)
 		if err != nil {
 			log.Error(fmt.Sprintf("%s failed", method), "err", err)
 			return nil, err
 		}
-
-		blacks, ok := ret[0].([]common.Address)
-		if !ok {
-			return []common.Address{}, errors.New("invalid blacklist format")
-		}
 		return blacks, nil
 	}
-	froms, err := get("getBlacksFrom")
+	froms, err := get("getBlacksFrom", contract.GetBlacksFrom)
 	if err != nil {
 		return nil, err
 	}
-	tos, err := get("getBlacksTo")
+	tos, err := get("getBlacksTo", contract.GetBlacksTo)
 	if err != nil {
 		return nil, err
 	}
@@ -1418,9 +1579,12 @@ func (c *Congress) getEventCheckRules(header *types.Header, parentState *state.S
 	}
 
 	// if the last updates is long ago, we don't need to get blacklist from the contract.
+	// The rules of the parent are read again at the fork block, as the location of
+	// their addresses is only known from the fork on.
 	num := header.Number.Uint64()
 	lastUpdated := lastRulesUpdatedNumber(parentState)
-	if num >= 2 && num > lastUpdated+1 {
+	forked := c.config.IsGovernance(header.Number)
+	if num >= 2 && num > lastUpdated+1 && forked == c.config.IsGovernance(new(big.Int).SetUint64(num-1)) {
 		parent := c.chain.GetHeader(header.ParentHash, num-1)
 		if parent != nil {
 			if v, ok := c.eventCheckRules.Get(parent.ParentHash); ok {
@@ -1434,85 +1598,47 @@ func (c *Congress) getEventCheckRules(header *types.Header, parentState *state.S
 	}
 
 	// can't get blacklist from cache, try to call the contract
-	alABI := c.abi[systemcontract.AddressListContractName]
-	method := "getRuleByIndex"
-	get := func(i uint32) (common.Hash, int, common.AddressCheckType, error) {
-		ret, err := c.commonCallContract(header, parentState, alABI, systemcontract.AddressListContractAddr, method, 3, i)
+	contract, err := c.addressListContract(header, parentState)
+	if err != nil {
+		return nil, err
+	}
+	get := func(i uint32) (common.Hash, common.AddressLocation, int, common.AddressCheckType, error) {
+		sig, checkIdx, ct, err := contract.GetRuleByIndex(nil, i)
 		if err != nil {
-			return common.Hash{}, 0, common.CheckNone, err
+			return common.Hash{}, common.LocationTopic, 0, common.CheckNone, err
 		}
-		sig := ret[0].([32]byte)
-		idx := ret[1].(*big.Int).Uint64()
-		ct := ret[2].(uint8)
-
-		return sig, int(idx), common.AddressCheckType(ct), nil
+		location, idx := systemcontract.UnpackRuleCheckIdx(checkIdx)
+		if !forked {
+			// Before the fork, every rule checks the topic of its index
+			location = common.LocationTopic
+		}
+		return sig, location, int(idx), common.AddressCheckType(ct), nil
 	}
 
-	cnt, err := c.getEventCheckRulesLen(header, parentState)
+	cnt, err := contract.RulesLen(nil)
 	if err != nil {
-		log.Error("getEventCheckRulesLen failed", "err", err)
+		log.Error("rulesLen failed", "err", err)
 		return nil, err
 	}
 	rules := make(map[common.Hash]*EventCheckRule)
-	for i := 0; i < cnt; i++ {
-		sig, idx, ct, err := get(uint32(i))
+	for i := uint32(0); i < cnt; i++ {
+		sig, location, idx, ct, err := get(i)
 		if err != nil {
 			log.Error("getRuleByIndex failed", "index", i, "number", num, "blockHash", header.Hash(), "err", err)
 			return nil, err
 		}
 		rule, exist := rules[sig]
 		if !exist {
-			rule = &EventCheckRule{
-				EventSig: sig,
-				Checks:   make(map[int]common.AddressCheckType),
-			}
+			rule = newEventCheckRule(sig)
 			rules[sig] = rule
 		}
-		rule.Checks[idx] = ct
+		rule.add(location, idx, ct)
 	}
 
 	c.eventCheckRules.Add(header.ParentHash, rules)
 	return rules, nil
 }
 
-func (c *Congress) getEventCheckRulesLen(header *types.Header, parentState *state.StateDB) (int, error) {
-	ret, err := c.commonCallContract(header, parentState, c.abi[systemcontract.AddressListContractName], systemcontract.AddressListContractAddr, "rulesLen", 1)
-	if err != nil {
-		return 0, err
-	}
-	ln, ok := ret[0].(uint32)
-	if !ok {
-		return 0, fmt.Errorf("unexpected output type, value: %v", ret[0])
-	}
-	return int(ln), nil
-}
-
-func (c *Congress) commonCallContract(header *types.Header, statedb *state.StateDB, contractABI abi.ABI, addr common.Address, method string, expectResultLen int, args ...interface{}) ([]interface{}, error) {
-	data, err := contractABI.Pack(method, args...)
-	if err != nil {
-		log.Error("Can't pack data ", "method", method, "err", err)
-		return nil, err
-	}
-
-	msg := vmcaller.NewLegacyMessage(header.Coinbase, &addr, 0, new(big.Int), math.MaxUint64, new(big.Int), data, false)
-
-	// Note: It's safe to use minimalChainContext for executing AddressListContract
-	result, err := vmcaller.ExecuteMsg(msg, statedb, header, newMinimalChainContext(c), c.chainConfig)
-	if err != nil {
-		return nil, err
-	}
-
-	// unpack data
-	ret, err := contractABI.Unpack(method, result)
-	if err != nil {
-		return nil, err
-	}
-	if len(ret) != expectResultLen {
-		return nil, errors.New("invalid result length")
-	}
-	return ret, nil
-}
-
 // Since the state variables are as follow:
 //    bool public initialized;
 //    bool public enabled;
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
@@ -30,67 +31,27 @@ type Proposal struct {
 }
 
 func (c *Congress) getPassedProposalCount(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB) (uint32, error) {
-
-	method := "getPassedProposalCount"
-	data, err := c.abi[systemcontract.SysGovContractName].Pack(method)
-	if err != nil {
-		log.Error("Can't pack data for getPassedProposalCount", "error", err)
-		return 0, err
-	}
-
-	msg := vmcaller.NewLegacyMessage(header.Coinbase, &systemcontract.SysGovContractAddr, 0, new(big.Int), math.MaxUint64, new(big.Int), data, false)
-
-	// use parent
-	result, err := vmcaller.ExecuteMsg(msg, state, header, newChainContext(chain, c), c.chainConfig)
-	if err != nil {
-		return 0, err
-	}
-
-	// unpack data
-	ret, err := c.abi[systemcontract.SysGovContractName].Unpack(method, result)
+	contract, err := c.sysGovContract(header, state, newChainContext(chain, c))
 	if err != nil {
 		return 0, err
 	}
-	if len(ret) != 1 {
-		return 0, errors.New("invalid output length")
-	}
-	count, ok := ret[0].(uint32)
-	if !ok {
-		return 0, errors.New("invalid count format")
-	}
-
-	return count, nil
+	return contract.GetPassedProposalCount(nil)
 }
 
 func (c *Congress) getPassedProposalByIndex(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, idx uint32) (*Proposal, error) {
-
-	method := "getPassedProposalByIndex"
-	data, err := c.abi[systemcontract.SysGovContractName].Pack(method, idx)
+	contract, err := c.sysGovContract(header, state, newChainContext(chain, c))
 	if err != nil {
-		log.Error("Can't pack data for getPassedProposalByIndex", "error", err)
 		return nil, err
 	}
-
-	msg := vmcaller.NewLegacyMessage(header.Coinbase, &systemcontract.SysGovContractAddr, 0, new(big.Int), math.MaxUint64, new(big.Int), data, false)
-
-	// use parent
-	result, err := vmcaller.ExecuteMsg(msg, state, header, newChainContext(chain, c), c.chainConfig)
-	if err != nil {
-		return nil, err
-	}
-
-	// unpack data
-	prop := &Proposal{}
-	err = c.abi[systemcontract.SysGovContractName].UnpackIntoInterface(prop, method, result)
+	prop, err := contract.GetPassedProposalByIndex(nil, idx)
 	if err != nil {
 		return nil, err
 	}
-
-	return prop, nil
+	return (*Proposal)(&prop), nil
 }
 
 //finishProposalById
-func (c *Congress) finishProposalById(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, id *big.Int) error {
+func (c *Congress) finishProposalById(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, id *big.Int, tracer consensus.SysCallTracer) error {
 	method := "finishProposalById"
 	data, err := c.abi[systemcontract.SysGovContractName].Pack(method, id)
 	if err != nil {
@@ -102,7 +63,7 @@ func (c *Congress) finishProposalById(chain consensus.ChainHeaderReader, header
 
 	// execute message without a transaction
 	state.Prepare(common.Hash{}, 0)
-	_, err = vmcaller.ExecuteMsg(msg, state, header, newChainContext(chain, c), c.chainConfig)
+	_, err = vmcaller.ExecuteTracedMsg(method, tracer, msg, state, header, newChainContext(chain, c), c.chainConfig)
 	if err != nil {
 		return err
 	}
@@ -169,11 +130,16 @@ func (c *Congress) replayProposal(chain consensus.ChainHeaderReader, header *typ
 func (c *Congress) executeProposalMsg(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, prop *Proposal, totalTxIndex int, txHash, bHash common.Hash) *types.Receipt {
 	var receipt *types.Receipt
 	action := prop.Action.Uint64()
-	switch action {
-	case 0:
+	switch {
+	case action == 0 && c.isNativeStore(header.Number, prop.To):
+		// native store action, the store has no code
+		err := c.executeNativeProposal(state, prop)
+		receipt = types.NewReceipt([]byte{}, err != nil, header.GasUsed)
+		log.Info("executeProposalMsg", "action", "native", "id", prop.Id.String(), "to", prop.To, "txHash", txHash.String(), "err", err)
+	case action == 0:
 		// evm action.
 		receipt = c.executeEvmCallProposal(chain, header, state, prop, totalTxIndex, txHash, bHash)
-	case 1:
+	case action == 1:
 		// delete code action
 		ok := state.Erase(prop.To)
 		receipt = types.NewReceipt([]byte{}, ok != true, header.GasUsed)
@@ -224,8 +190,12 @@ func (c *Congress) ApplySysTx(evm *vm.EVM, state *state.StateDB, txIndex int, se
 	evm.StateDB.SetNonce(sender, nonce+1)
 
 	action := prop.Action.Uint64()
-	switch action {
-	case 0:
+	switch {
+	case action == 0 && c.isNativeStore(evm.Context.BlockNumber, prop.To):
+		// native store action, the store has no code
+		vmerr = c.executeNativeProposal(state, prop)
+		state.Finalise(true)
+	case action == 0:
 		// evm action.
 		// actually run the governance message
 		msg := vmcaller.NewLegacyMessage(prop.From, &prop.To, 0, prop.Value, tx.Gas(), new(big.Int), prop.Data, false)
@@ -236,7 +206,7 @@ func (c *Congress) ApplySysTx(evm *vm.EVM, state *state.StateDB, txIndex int, se
 		}
 		ret, _, vmerr = evm.Call(vm.AccountRef(msg.From()), *msg.To(), msg.Data(), msg.Gas(), msg.Value())
 		state.Finalise(true)
-	case 1:
+	case action == 1:
 		// delete code action
 		_ = state.Erase(prop.To)
 	default:
@@ -244,3 +214,99 @@ func (c *Congress) ApplySysTx(evm *vm.EVM, state *state.StateDB, txIndex int, se
 	}
 	return
 }
+
+// TraceFinalizeCalls executes the system contract calls made by Finalize before
+// the system-transactions of the block, reporting each of them to the tracer.
+// The state must be the one after all the ordinary transactions of the block.
+func (c *Congress) TraceFinalizeCalls(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, hasTxs bool, tracer consensus.SysCallTracer) error {
+	if header.Number.Cmp(common.Big1) == 0 {
+		if err := c.initializeSystemContracts(chain, header, state, tracer); err != nil {
+			return err
+		}
+	}
+	if header.Difficulty.Cmp(diffInTurn) != 0 {
+		if err := c.tryPunishValidator(chain, header, state, tracer); err != nil {
+			return err
+		}
+	}
+	if hasTxs {
+		if _, err := c.trySendBlockReward(chain, header, state, tracer); err != nil {
+			return err
+		}
+	}
+	isEpoch, cp, err := c.isEpoch(chain, header, nil)
+	if err != nil {
+		return err
+	}
+	if isEpoch {
+		if _, err := c.doSomethingAtEpoch(chain, header, cp, state, tracer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TraceFinishProposals executes the calls finishing the proposals executed by the
+// given system-transactions, reporting each of them to the tracer.
+func (c *Congress) TraceFinishProposals(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, sysTxs []*types.Transaction, tracer consensus.SysCallTracer) error {
+	for _, tx := range sysTxs {
+		prop := new(Proposal)
+		if err := rlp.DecodeBytes(tx.Data(), prop); err != nil {
+			return err
+		}
+		if err := c.finishProposalById(chain, header, state, prop.Id, tracer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isNativeStore tells whether the given address is a system store without code,
+// whose system governance proposals are executed by the engine. Before the
+// governance fork, proposals to the stores are plain calls to code-less accounts.
+func (c *Congress) isNativeStore(number *big.Int, addr common.Address) bool {
+	if !c.config.IsGovernance(number) {
+		return false
+	}
+	return addr == systemcontract.ConsensusParamsContractAddr || addr == systemcontract.NodeListContractAddr
+}
+
+// executeNativeProposal executes a system governance proposal calling a system
+// store without code.
+func (c *Congress) executeNativeProposal(state *state.StateDB, prop *Proposal) error {
+	switch prop.To {
+	case systemcontract.ConsensusParamsContractAddr:
+		return c.executeParamsProposal(state, prop)
+	case systemcontract.NodeListContractAddr:
+		return c.executeNodeListProposal(state, prop)
+	}
+	return fmt.Errorf("no native store at %s", prop.To.Hex())
+}
+
+// unpackNativeProposal decodes the method call of a system governance proposal
+// to a system store without code.
+func unpackNativeProposal(contractABI abi.ABI, prop *Proposal) (string, []interface{}, error) {
+	if prop.Value != nil && prop.Value.Sign() != 0 {
+		return "", nil, errors.New("value transfer to a native store")
+	}
+	if len(prop.Data) < 4 {
+		return "", nil, errors.New("missing method")
+	}
+	method, err := contractABI.MethodById(prop.Data[:4])
+	if err != nil {
+		return "", nil, err
+	}
+	args, err := method.Inputs.Unpack(prop.Data[4:])
+	if err != nil {
+		return "", nil, err
+	}
+	return method.Name, args, nil
+}
+
+// keepNativeStore keeps a system store without code from being deleted as an
+// empty account.
+func keepNativeStore(state *state.StateDB, addr common.Address) {
+	if state.GetNonce(addr) == 0 {
+		state.SetNonce(addr, 1)
+	}
+}
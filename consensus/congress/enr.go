@@ -0,0 +1,128 @@
+package congress
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// enrSigPrefix separates the signatures of node IDs from the ones of headers.
+var enrSigPrefix = []byte("congress node")
+
+// ENREntry is the "congress" ENR entry proving a node is run by a validator, who
+// signs the ID of the node with its validator key.
+type ENREntry struct {
+	Validator common.Address
+	Signature []byte
+
+	// Ignore additional fields (for forward compatibility).
+	Rest []rlp.RawValue `rlp:"tail"`
+}
+
+// ENRKey implements enr.Entry.
+func (e ENREntry) ENRKey() string {
+	return "congress"
+}
+
+// enrSigData returns the data signed by the validator for the given node.
+func enrSigData(id enode.ID) []byte {
+	return append(append([]byte{}, enrSigPrefix...), id[:]...)
+}
+
+// NewENREntry creates the ENR entry of the given local node, signed with the key
+// of the authorized validator.
+func (c *Congress) NewENREntry(id enode.ID) (*ENREntry, error) {
+	c.lock.RLock()
+	val, signFn := c.validator, c.signFn
+	c.lock.RUnlock()
+
+	if signFn == nil {
+		return nil, errors.New("no authorized validator")
+	}
+	sig, err := signFn(accounts.Account{Address: val}, accounts.MimetypeCongress, enrSigData(id))
+	if err != nil {
+		return nil, err
+	}
+	return &ENREntry{Validator: val, Signature: sig}, nil
+}
+
+// SetLocalNode sets the local node whose record advertises the authorized
+// validator, signing its entry right away if a validator is already authorized.
+func (c *Congress) SetLocalNode(ln *enode.LocalNode) {
+	c.lock.Lock()
+	c.localNode = ln
+	c.lock.Unlock()
+
+	c.updateENR()
+}
+
+// updateENR sets the "congress" entry of the local node for the authorized
+// validator, if both are known.
+func (c *Congress) updateENR() {
+	c.lock.RLock()
+	ln, signFn := c.localNode, c.signFn
+	c.lock.RUnlock()
+
+	if ln == nil || signFn == nil {
+		return
+	}
+	entry, err := c.NewENREntry(ln.ID())
+	if err != nil {
+		log.Warn("Failed to sign validator ENR entry", "err", err)
+		return
+	}
+	ln.Set(entry)
+}
+
+// ENRValidator returns the validator proven to run the given node by its ENR
+// entry, and whether it's a validator at the given block.
+func (c *Congress) ENRValidator(chain consensus.ChainHeaderReader, head common.Hash, node *enode.Node) (common.Address, bool) {
+	var entry ENREntry
+	if node.Load(&entry) != nil || len(entry.Signature) != crypto.SignatureLength {
+		return common.Address{}, false
+	}
+	pubkey, err := crypto.Ecrecover(crypto.Keccak256(enrSigData(node.ID())), entry.Signature)
+	if err != nil {
+		return common.Address{}, false
+	}
+	var signer common.Address
+	copy(signer[:], crypto.Keccak256(pubkey[1:])[12:])
+	if signer != entry.Validator {
+		return common.Address{}, false
+	}
+	header := chain.GetHeaderByHash(head)
+	if header == nil {
+		return signer, false
+	}
+	snap, err := c.snapshot(chain, header.Number.Uint64(), head, nil)
+	if err != nil {
+		return signer, false
+	}
+	_, ok := snap.Validators[signer]
+	return signer, ok
+}
+
+// IsValidator tells whether the authorized validator is a validator at the
+// given block.
+func (c *Congress) IsValidator(chain consensus.ChainHeaderReader, head common.Hash) bool {
+	c.lock.RLock()
+	val, signFn := c.validator, c.signFn
+	c.lock.RUnlock()
+
+	header := chain.GetHeaderByHash(head)
+	if signFn == nil || header == nil {
+		return false
+	}
+	snap, err := c.snapshot(chain, header.Number.Uint64(), head, nil)
+	if err != nil {
+		return false
+	}
+	_, ok := snap.Validators[val]
+	return ok
+}
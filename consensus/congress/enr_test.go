@@ -0,0 +1,116 @@
+package congress
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestENRValidator(t *testing.T) {
+	var (
+		valKey, _  = crypto.GenerateKey()
+		nodeKey, _ = crypto.GenerateKey()
+		val        = crypto.PubkeyToAddress(valKey.PublicKey)
+		config     = &params.CongressConfig{Epoch: 2}
+		recents    = mustNewARC(8)
+		engine     = &Congress{config: config, recents: recents}
+		genesis    = &types.Header{Number: big.NewInt(0), Extra: epochExtra(val)}
+		chain      = testHeaderChain{genesis}
+	)
+	newNode := func(entry enr.Entry) *enode.Node {
+		var r enr.Record
+		if entry != nil {
+			r.Set(entry)
+		}
+		if err := enode.SignV4(&r, nodeKey); err != nil {
+			t.Fatalf("failed to sign record: %v", err)
+		}
+		node, err := enode.New(enode.ValidSchemes, &r)
+		if err != nil {
+			t.Fatalf("failed to create node: %v", err)
+		}
+		return node
+	}
+	id := newNode(nil).ID()
+	if _, err := engine.NewENREntry(id); err == nil {
+		t.Fatalf("entry signed without an authorized validator")
+	}
+	engine.Authorize(val, func(account accounts.Account, mimeType string, data []byte) ([]byte, error) {
+		return crypto.Sign(crypto.Keccak256(data), valKey)
+	}, nil)
+
+	entry, err := engine.NewENREntry(id)
+	if err != nil {
+		t.Fatalf("failed to create entry: %v", err)
+	}
+	recents.Add(genesis.Hash(), newSnapshot(config, nil, 0, genesis.Hash(), []common.Address{val}))
+	if signer, ok := engine.ENRValidator(chain, genesis.Hash(), newNode(entry)); !ok || signer != val {
+		t.Fatalf("validator node not recognized: %x, %v", signer, ok)
+	}
+	if !engine.IsValidator(chain, genesis.Hash()) {
+		t.Fatalf("authorized validator not recognized")
+	}
+	// An entry claiming another validator, or signed for another node, is rejected
+	forged := &ENREntry{Validator: common.HexToAddress("0x0a"), Signature: entry.Signature}
+	if _, ok := engine.ENRValidator(chain, genesis.Hash(), newNode(forged)); ok {
+		t.Fatalf("forged entry accepted")
+	}
+	other, _ := engine.NewENREntry(enode.ID{0x01})
+	if _, ok := engine.ENRValidator(chain, genesis.Hash(), newNode(other)); ok {
+		t.Fatalf("entry of another node accepted")
+	}
+	// Nodes of former validators are not recognized
+	recents.Add(genesis.Hash(), newSnapshot(config, nil, 0, genesis.Hash(), []common.Address{common.HexToAddress("0x0b")}))
+	if _, ok := engine.ENRValidator(chain, genesis.Hash(), newNode(entry)); ok {
+		t.Fatalf("node of a former validator recognized")
+	}
+}
+
+func TestLocalNodeENR(t *testing.T) {
+	var (
+		valKey, _ = crypto.GenerateKey()
+		val       = crypto.PubkeyToAddress(valKey.PublicKey)
+		signFn    = func(account accounts.Account, mimeType string, data []byte) ([]byte, error) {
+			return crypto.Sign(crypto.Keccak256(data), valKey)
+		}
+	)
+	newLocalNode := func() *enode.LocalNode {
+		db, err := enode.OpenDB("")
+		if err != nil {
+			t.Fatalf("failed to open node database: %v", err)
+		}
+		t.Cleanup(db.Close)
+		key, _ := crypto.GenerateKey()
+		return enode.NewLocalNode(db, key)
+	}
+	advertised := func(ln *enode.LocalNode) bool {
+		var entry ENREntry
+		return ln.Node().Load(&entry) == nil && entry.Validator == val
+	}
+	// The entry is set whether the validator is authorized before or after the
+	// local node is known
+	ln := newLocalNode()
+	engine := new(Congress)
+	engine.SetLocalNode(ln)
+	if advertised(ln) {
+		t.Fatalf("entry set without an authorized validator")
+	}
+	engine.Authorize(val, signFn, nil)
+	if !advertised(ln) {
+		t.Fatalf("entry not set on authorization")
+	}
+	ln = newLocalNode()
+	engine = new(Congress)
+	engine.Authorize(val, signFn, nil)
+	engine.SetLocalNode(ln)
+	if !advertised(ln) {
+		t.Fatalf("entry not set for an authorized validator")
+	}
+}
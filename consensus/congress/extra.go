@@ -0,0 +1,48 @@
+package congress
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// EpochValidators parses the validator set out of the extra data of an epoch header.
+// The set is empty for the headers of other blocks.
+func EpochValidators(header *types.Header) ([]common.Address, error) {
+	if len(header.Extra) < extraVanity+extraSeal {
+		return nil, errMissingSignature
+	}
+	signers := header.Extra[extraVanity : len(header.Extra)-extraSeal]
+	if len(signers)%common.AddressLength != 0 {
+		return nil, errInvalidCheckpointValidators
+	}
+	validators := make([]common.Address, len(signers)/common.AddressLength)
+	for i := range validators {
+		copy(validators[i][:], signers[i*common.AddressLength:])
+	}
+	return validators, nil
+}
+
+// EpochExtra builds the extra data of an epoch header, e.g. the genesis, out of
+// the vanity and the validator set, leaving the seal empty. The validators are
+// sorted in ascending order as done for sealed epoch headers.
+func EpochExtra(vanity []byte, validators []common.Address) ([]byte, error) {
+	if len(vanity) > extraVanity {
+		return nil, fmt.Errorf("vanity too long: %d > %d bytes", len(vanity), extraVanity)
+	}
+	if len(validators) == 0 {
+		return nil, errInvalidCheckpointValidators
+	}
+	sorted := make([]common.Address, len(validators))
+	copy(sorted, validators)
+	sort.Sort(validatorsAscending(sorted))
+
+	extra := make([]byte, extraVanity, extraVanity+len(sorted)*common.AddressLength+extraSeal)
+	copy(extra, vanity)
+	for _, validator := range sorted {
+		extra = append(extra, validator[:]...)
+	}
+	return append(extra, make([]byte, extraSeal)...), nil
+}
@@ -0,0 +1,39 @@
+package congress
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func epochExtra(validators ...common.Address) []byte {
+	extra := make([]byte, extraVanity)
+	for _, val := range validators {
+		extra = append(extra, val.Bytes()...)
+	}
+	return append(extra, make([]byte, extraSeal)...)
+}
+
+func TestEpochExtra(t *testing.T) {
+	a, b := common.HexToAddress("0x0a"), common.HexToAddress("0x0b")
+
+	extra, err := EpochExtra([]byte("vanity"), []common.Address{b, a})
+	if err != nil {
+		t.Fatalf("failed to build extra: %v", err)
+	}
+	if want := epochExtra(a, b); !bytes.Equal(extra[extraVanity:], want[extraVanity:]) || !bytes.HasPrefix(extra, []byte("vanity")) {
+		t.Fatalf("extra mismatch: have %x, want vanity and %x", extra, want[extraVanity:])
+	}
+	validators, err := EpochValidators(&types.Header{Extra: extra})
+	if err != nil || len(validators) != 2 || validators[0] != a || validators[1] != b {
+		t.Fatalf("validators mismatch: %x, err %v", validators, err)
+	}
+	if _, err := EpochExtra(make([]byte, extraVanity+1), []common.Address{a}); err == nil {
+		t.Error("overlong vanity accepted")
+	}
+	if _, err := EpochExtra(nil, nil); err == nil {
+		t.Error("empty validator set accepted")
+	}
+}
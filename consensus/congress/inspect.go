@@ -0,0 +1,181 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package congress
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// HeaderDump is the congress view of a header for debugging tools, with the
+// extra data split into its parts.
+type HeaderDump struct {
+	Number     uint64           `json:"number"`
+	Hash       common.Hash      `json:"hash"`
+	SealHash   common.Hash      `json:"sealHash"`
+	Vanity     hexutil.Bytes    `json:"vanity"`
+	Validators []common.Address `json:"validators,omitempty"` // Validator set of epoch headers
+	Params     *Params          `json:"params,omitempty"`     // Consensus parameters of governed epoch headers
+	Seal       hexutil.Bytes    `json:"seal"`
+	Signer     *common.Address  `json:"signer,omitempty"`
+	InTurn     bool             `json:"inTurn"`
+	Problems   []string         `json:"problems,omitempty"` // Malformed fields, empty for valid headers
+}
+
+// DumpHeader splits the extra data of the header, recovers its signer and
+// checks the layout against the given consensus configuration. Epoch headers
+// are told apart by their validator list, since the epoch length may be changed
+// by governance. Malformed data is reported in the problems of the dump rather
+// than as an error, so that broken headers can still be inspected.
+func DumpHeader(header *types.Header, config *params.CongressConfig) *HeaderDump {
+	dump := &HeaderDump{
+		Number: header.Number.Uint64(),
+		Hash:   header.Hash(),
+		InTurn: header.Difficulty != nil && IsInTurn(header),
+	}
+	problemf := func(format string, args ...interface{}) {
+		dump.Problems = append(dump.Problems, fmt.Sprintf(format, args...))
+	}
+	if header.Difficulty == nil || (header.Difficulty.Cmp(diffInTurn) != 0 && header.Difficulty.Cmp(diffNoTurn) != 0) {
+		problemf("invalid difficulty %v", header.Difficulty)
+	}
+	if len(header.Extra) < extraVanity+extraSeal {
+		problemf("extra data too short: %d < %d bytes", len(header.Extra), extraVanity+extraSeal)
+		dump.Vanity = common.CopyBytes(header.Extra)
+		return dump
+	}
+	dump.SealHash = SealHash(header)
+	dump.Vanity = common.CopyBytes(header.Extra[:extraVanity])
+	dump.Seal = common.CopyBytes(header.Extra[len(header.Extra)-extraSeal:])
+
+	// Before the governance fork, the epoch length is the configured one
+	var (
+		governed    = config.IsGovernance(header.Number)
+		staticEpoch = config.Epoch != 0 && dump.Number%config.Epoch == 0
+		signers     = header.Extra[extraVanity : len(header.Extra)-extraSeal]
+	)
+	switch isEpoch := IsEpochHeader(header); {
+	case len(signers)%common.AddressLength != 0:
+		problemf("validator list of %d bytes is not a multiple of %d", len(signers), common.AddressLength)
+	case isEpoch && !governed && !staticEpoch:
+		problemf("validators in the extra data of a non-epoch block: %d bytes", len(signers))
+	case !isEpoch && !governed && staticEpoch:
+		problemf("empty validator list on epoch block")
+	case isEpoch:
+		dump.Validators, _ = EpochValidators(header)
+		for i := 1; i < len(dump.Validators); i++ {
+			if bytes.Compare(dump.Validators[i-1][:], dump.Validators[i][:]) >= 0 {
+				problemf("validators not in ascending order at index %d", i)
+				break
+			}
+		}
+	}
+	// The mix digest carries the consensus parameters of governed epoch blocks
+	if governed && dump.Validators != nil {
+		p, err := decodeParams(header.MixDigest)
+		switch {
+		case err != nil:
+			problemf("invalid consensus parameters: %v", err)
+		case p == nil:
+			problemf("missing consensus parameters on epoch block")
+		}
+		dump.Params = p
+	} else if header.MixDigest != (common.Hash{}) {
+		problemf("non-zero mix digest on a block without consensus parameters: %x", header.MixDigest)
+	}
+	// The genesis is not sealed
+	if dump.Number == 0 {
+		return dump
+	}
+	signer, err := ecrecover(header, nil)
+	if err != nil {
+		problemf("can't recover signer: %v", err)
+		return dump
+	}
+	dump.Signer = &signer
+	if signer != header.Coinbase {
+		problemf("signer %x differs from coinbase %x", signer, header.Coinbase)
+	}
+	return dump
+}
+
+// ProposalDump is the view of a system governance proposal for debugging tools.
+type ProposalDump struct {
+	Tx     common.Hash    `json:"tx"`
+	Error  string         `json:"error,omitempty"` // Set if the transaction carries no valid proposal
+	Id     *big.Int       `json:"id"`
+	Action *big.Int       `json:"action"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *big.Int       `json:"value"`
+	Data   hexutil.Bytes  `json:"data"`
+}
+
+// DumpProposal decodes the system governance proposal executed by the given
+// transaction. It returns nil if the transaction is not a governance one.
+func DumpProposal(tx *types.Transaction) (*ProposalDump, error) {
+	if tx.To() == nil || *tx.To() != systemcontract.SysGovToAddr {
+		return nil, nil
+	}
+	prop := new(Proposal)
+	if err := rlp.DecodeBytes(tx.Data(), prop); err != nil {
+		return nil, fmt.Errorf("invalid governance transaction %x: %v", tx.Hash(), err)
+	}
+	return &ProposalDump{
+		Tx:     tx.Hash(),
+		Id:     prop.Id,
+		Action: prop.Action,
+		From:   prop.From,
+		To:     prop.To,
+		Value:  prop.Value,
+		Data:   prop.Data,
+	}, nil
+}
+
+// DumpBlockProposals decodes the governance proposals executed by the system
+// transactions of a block. A system transaction without a valid proposal is
+// reported in the dump instead of failing it, other transactions are skipped.
+func DumpBlockProposals(signer types.Signer, header *types.Header, txs types.Transactions) []*ProposalDump {
+	var props []*ProposalDump
+	for _, tx := range txs {
+		sender, err := types.Sender(signer, tx)
+		if err != nil || !isProposalTransaction(sender, tx, header) {
+			continue
+		}
+		prop, err := DumpProposal(tx)
+		if err != nil {
+			prop = &ProposalDump{Tx: tx.Hash(), Error: err.Error()}
+		}
+		props = append(props, prop)
+	}
+	return props
+}
+
+// IsSnapshotKey reports whether the database key is the one of a validator
+// snapshot, as opposed to the other records sharing its prefix, none of which
+// has a key of the length of a snapshot one.
+func IsSnapshotKey(key []byte) bool {
+	return len(key) == len(snapshotPrefix)+common.HashLength && bytes.HasPrefix(key, snapshotPrefix)
+}
@@ -0,0 +1,150 @@
+package congress
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// sealHeader signs the header with the key, setting its coinbase.
+func sealHeader(t *testing.T, header *types.Header, key []byte) {
+	priv, _ := crypto.ToECDSA(key)
+	header.Coinbase = crypto.PubkeyToAddress(priv.PublicKey)
+	sig, err := crypto.Sign(SealHash(header).Bytes(), priv)
+	if err != nil {
+		t.Fatalf("failed to sign header: %v", err)
+	}
+	copy(header.Extra[len(header.Extra)-extraSeal:], sig)
+}
+
+func TestDumpHeader(t *testing.T) {
+	var (
+		key    = common.FromHex("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		a, b   = common.HexToAddress("0x0a"), common.HexToAddress("0x0b")
+		header = &types.Header{Number: big.NewInt(4), Difficulty: diffInTurn, Extra: epochExtra(a, b)}
+		config = &params.CongressConfig{Epoch: 4}
+	)
+	sealHeader(t, header, key)
+
+	dump := DumpHeader(header, config)
+	if len(dump.Problems) != 0 {
+		t.Fatalf("valid header reported as malformed: %v", dump.Problems)
+	}
+	if dump.Signer == nil || *dump.Signer != header.Coinbase || !dump.InTurn {
+		t.Errorf("signer mismatch: have %v (in-turn %v), want %x", dump.Signer, dump.InTurn, header.Coinbase)
+	}
+	if len(dump.Validators) != 2 || dump.Validators[0] != a || dump.Validators[1] != b {
+		t.Errorf("validators mismatch: %x", dump.Validators)
+	}
+	// The same validators on a non-epoch block are malformed
+	if dump = DumpHeader(header, &params.CongressConfig{Epoch: 3}); len(dump.Problems) != 1 || dump.Validators != nil {
+		t.Errorf("non-epoch validators not flagged: %v", dump.Problems)
+	}
+	// So are unordered validators and a misaligned list
+	header = &types.Header{Number: big.NewInt(4), Difficulty: diffNoTurn, Extra: epochExtra(b, a)}
+	sealHeader(t, header, key)
+	if dump = DumpHeader(header, config); len(dump.Problems) != 1 || dump.InTurn {
+		t.Errorf("unordered validators not flagged: %v", dump.Problems)
+	}
+	header.Extra = append(epochExtra(a), 0x01)
+	if dump = DumpHeader(header, config); len(dump.Problems) < 1 {
+		t.Errorf("misaligned validators not flagged")
+	}
+	if dump = DumpHeader(&types.Header{Number: big.NewInt(1), Difficulty: diffInTurn, Extra: []byte{0x01}}, config); len(dump.Problems) != 1 {
+		t.Errorf("short extra data not flagged: %v", dump.Problems)
+	}
+	// After the governance fork, epoch blocks are told apart by their validators
+	// and carry the consensus parameters, whatever the configured epoch length
+	config = &params.CongressConfig{Epoch: 4, GovernanceBlock: common.Big0}
+	governed := defaultParams(config)
+	governed.Epoch = 30
+
+	header = &types.Header{Number: big.NewInt(30), Difficulty: diffInTurn, Extra: epochExtra(a, b), MixDigest: governed.encode()}
+	sealHeader(t, header, key)
+	if dump = DumpHeader(header, config); len(dump.Problems) != 0 || len(dump.Validators) != 2 || !reflect.DeepEqual(dump.Params, governed) {
+		t.Errorf("governed epoch block mismatch: params %+v, problems %v", dump.Params, dump.Problems)
+	}
+	header.MixDigest = common.Hash{}
+	sealHeader(t, header, key)
+	if dump = DumpHeader(header, config); len(dump.Problems) != 1 {
+		t.Errorf("missing consensus parameters not flagged: %v", dump.Problems)
+	}
+	header = &types.Header{Number: big.NewInt(31), Difficulty: diffInTurn, Extra: epochExtra(), MixDigest: governed.encode()}
+	sealHeader(t, header, key)
+	if dump = DumpHeader(header, config); len(dump.Problems) != 1 || dump.Params != nil {
+		t.Errorf("consensus parameters of a non-epoch block not flagged: %v", dump.Problems)
+	}
+}
+
+func TestDumpProposal(t *testing.T) {
+	prop := &Proposal{Id: big.NewInt(7), Action: big.NewInt(1), From: common.HexToAddress("0x01"), To: common.HexToAddress("0x02"), Value: big.NewInt(0), Data: []byte{0xca, 0xfe}}
+	data, _ := rlp.EncodeToBytes(prop)
+
+	dump, err := DumpProposal(types.NewTransaction(0, systemcontract.SysGovToAddr, new(big.Int), 0, new(big.Int), data))
+	if err != nil || dump == nil {
+		t.Fatalf("failed to dump proposal: %v", err)
+	}
+	if dump.Id.Int64() != 7 || dump.To != prop.To || string(dump.Data) != string(prop.Data) {
+		t.Errorf("proposal mismatch: %+v", dump)
+	}
+	if dump, err = DumpProposal(types.NewTransaction(0, common.Address{}, new(big.Int), 0, new(big.Int), data)); dump != nil || err != nil {
+		t.Errorf("plain transaction decoded as proposal: %+v, err %v", dump, err)
+	}
+	if _, err = DumpProposal(types.NewTransaction(0, systemcontract.SysGovToAddr, new(big.Int), 0, new(big.Int), []byte{0x01})); err == nil {
+		t.Error("malformed proposal decoded")
+	}
+}
+
+func TestDumpBlockProposals(t *testing.T) {
+	var (
+		minerKey, _ = crypto.GenerateKey()
+		userKey, _  = crypto.GenerateKey()
+		signer      = types.HomesteadSigner{}
+		header      = &types.Header{Number: big.NewInt(1), Coinbase: crypto.PubkeyToAddress(minerKey.PublicKey)}
+	)
+	prop := &Proposal{Id: big.NewInt(7), Action: big.NewInt(1), Value: big.NewInt(0)}
+	data, _ := rlp.EncodeToBytes(prop)
+	sign := func(key *ecdsa.PrivateKey, nonce uint64, gasPrice int64, data []byte) *types.Transaction {
+		tx, err := types.SignTx(types.NewTransaction(nonce, systemcontract.SysGovToAddr, new(big.Int), 0, big.NewInt(gasPrice), data), signer, key)
+		if err != nil {
+			t.Fatalf("failed to sign transaction: %v", err)
+		}
+		return tx
+	}
+	txs := types.Transactions{
+		sign(userKey, 0, 0, []byte{0x01}),  // not sent by the coinbase
+		sign(minerKey, 0, 1, []byte{0x01}), // not a system-transaction, it pays gas
+		sign(minerKey, 1, 0, data),
+		sign(minerKey, 2, 0, []byte{0x01}),
+	}
+	props := DumpBlockProposals(signer, header, txs)
+	if len(props) != 2 {
+		t.Fatalf("have %d proposals, want 2: %+v", len(props), props)
+	}
+	if props[0].Tx != txs[2].Hash() || props[0].Error != "" || props[0].Id.Int64() != 7 {
+		t.Errorf("proposal mismatch: %+v", props[0])
+	}
+	if props[1].Tx != txs[3].Hash() || props[1].Error == "" || props[1].Id != nil {
+		t.Errorf("malformed proposal not reported: %+v", props[1])
+	}
+}
+
+func TestIsSnapshotKey(t *testing.T) {
+	if !IsSnapshotKey(append(common.CopyBytes(snapshotPrefix), common.Hash{}.Bytes()...)) {
+		t.Error("snapshot key not recognized")
+	}
+	if IsSnapshotKey(append(common.CopyBytes(rewardPrefix), common.Hash{}.Bytes()...)) {
+		t.Error("reward key recognized as snapshot")
+	}
+	if IsSnapshotKey(policyLogKey(1, 2)) || IsSnapshotKey(policyAddrKey(common.Address{}, 1, 2)) {
+		t.Error("policy history key recognized as snapshot")
+	}
+}
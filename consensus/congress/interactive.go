@@ -3,6 +3,11 @@ package congress
 import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract/bindings"
+	"github.com/ethereum/go-ethereum/consensus/congress/vmcaller"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -49,3 +54,33 @@ func (cc *minimalChainContext) Engine() consensus.Engine {
 func (cc *minimalChainContext) GetHeader(hash common.Hash, number uint64) *types.Header {
 	return nil
 }
+
+// validatorsContract returns the binding of the validators contract in effect at
+// the header, calling it against the given state.
+func (c *Congress) validatorsContract(header *types.Header, statedb *state.StateDB, chainContext core.ChainContext) (*bindings.ValidatorsCaller, error) {
+	caller := vmcaller.NewContractCaller(statedb, header, chainContext, c.chainConfig)
+	return bindings.NewValidatorsCaller(*systemcontract.GetValidatorAddr(header.Number, c.chainConfig), caller)
+}
+
+// votePoolContract returns the binding of the given vote pool of a validator,
+// calling it against the given state.
+func (c *Congress) votePoolContract(header *types.Header, statedb *state.StateDB, chainContext core.ChainContext, pool common.Address) (*bindings.VotePoolCaller, error) {
+	caller := vmcaller.NewContractCaller(statedb, header, chainContext, c.chainConfig)
+	return bindings.NewVotePoolCaller(pool, caller)
+}
+
+// sysGovContract returns the binding of the system governance contract, calling
+// it against the given state.
+func (c *Congress) sysGovContract(header *types.Header, statedb *state.StateDB, chainContext core.ChainContext) (*bindings.SysGovCaller, error) {
+	caller := vmcaller.NewContractCaller(statedb, header, chainContext, c.chainConfig)
+	return bindings.NewSysGovCaller(systemcontract.SysGovContractAddr, caller)
+}
+
+// addressListContract returns the binding of the developer address list contract,
+// calling it against the given state.
+//
+// Note: It's safe to use minimalChainContext for executing AddressListContract
+func (c *Congress) addressListContract(header *types.Header, statedb *state.StateDB) (*bindings.AddressListCaller, error) {
+	caller := vmcaller.NewContractCaller(statedb, header, newMinimalChainContext(c), c.chainConfig)
+	return bindings.NewAddressListCaller(systemcontract.AddressListContractAddr, caller)
+}
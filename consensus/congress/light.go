@@ -0,0 +1,121 @@
+package congress
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// maxEpochLookups is the maximum number of headers looked up to find the latest
+// epoch block covered by a trusted checkpoint.
+const maxEpochLookups = 1024
+
+// errNoTrustedEpoch is returned if no epoch block can be found below a trusted
+// checkpoint within the lookup limit.
+var errNoTrustedEpoch = errors.New("no epoch block found")
+
+// IsEpochHeader tells whether the given header is the one of an epoch block, by
+// the validator list in its extra-data.
+func IsEpochHeader(header *types.Header) bool {
+	validators, err := EpochValidators(header)
+	return err == nil && len(validators) > 0
+}
+
+// epochSnapshot creates the snapshot of a trusted epoch block, taking the validator
+// set from its extra-data and the consensus parameters from its mix digest.
+func (c *Congress) epochSnapshot(header *types.Header) (*Snapshot, error) {
+	validators, err := EpochValidators(header)
+	if err != nil {
+		return nil, err
+	}
+	if len(validators) == 0 {
+		return nil, errInvalidCheckpointValidators
+	}
+	snap := newSnapshot(c.config, c.signatures, header.Number.Uint64(), header.Hash(), validators)
+	if c.config.IsGovernance(header.Number) {
+		if snap.Params, err = decodeParams(header.MixDigest); err != nil {
+			return nil, err
+		}
+	}
+	return snap, nil
+}
+
+// LatestEpoch returns the latest epoch block at or below the given number, which
+// light clients start from. The headers are looked up with the given function,
+// supposed to retrieve trusted headers (i.e. proven by a checkpoint).
+//
+// The search starts from the given epoch length, the one in effect at the trusted
+// checkpoint. If zero, the length governed by the last trusted epoch block is
+// used, or the configured one without any. The search jumps back by that length
+// until an epoch block is found, then forward by the lengths governed by every
+// epoch block found.
+func (c *Congress) LatestEpoch(number uint64, epoch uint64, getHeader func(uint64) (*types.Header, error)) (*types.Header, error) {
+	if epoch == 0 {
+		c.lock.RLock()
+		epoch = c.trustedEpoch
+		c.lock.RUnlock()
+	}
+	if epoch == 0 {
+		epoch = c.config.Epoch
+	}
+	var (
+		found *types.Header
+		next  = number - number%epoch
+	)
+	for i := 0; i < maxEpochLookups; i++ {
+		header, err := getHeader(next)
+		if err != nil {
+			return nil, err
+		}
+		if header == nil || header.Number.Uint64() != next {
+			return nil, fmt.Errorf("missing header #%d", next)
+		}
+		if !IsEpochHeader(header) {
+			switch {
+			case found != nil:
+				// The length was governed again in between, step to the epoch
+				// block following the found one
+				p := c.epochParams(found)
+				next = (found.Number.Uint64()/p.Epoch + 1) * p.Epoch
+			case next == 0:
+				return nil, errNoTrustedEpoch
+			case next < epoch:
+				next = 0
+			default:
+				next -= epoch
+			}
+			continue
+		}
+		found = header
+		p := c.epochParams(header)
+		if candidate := number - number%p.Epoch; candidate > next {
+			next = candidate
+			continue
+		}
+		return found, nil
+	}
+	return nil, errNoTrustedEpoch
+}
+
+// AddTrustedEpoch trusts the given epoch block as the starting point of the header
+// verification, without its ancestors. The following headers are verified by the
+// seal and recent signer rules against the validator set of the epoch block, until
+// the next epoch block replaces it.
+func (c *Congress) AddTrustedEpoch(header *types.Header) error {
+	snap, err := c.epochSnapshot(header)
+	if err != nil {
+		return err
+	}
+	if err := snap.store(c.db); err != nil {
+		return err
+	}
+	c.recents.Add(snap.Hash, snap)
+	c.lock.Lock()
+	c.trustedEpoch = snap.params().Epoch
+	c.lock.Unlock()
+
+	log.Info("Added trusted epoch", "number", snap.Number, "hash", snap.Hash, "validators", len(snap.Validators))
+	return nil
+}
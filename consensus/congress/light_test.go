@@ -0,0 +1,132 @@
+package congress
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestLatestEpoch(t *testing.T) {
+	var (
+		config = &params.CongressConfig{Epoch: 16, GovernanceBlock: common.Big0}
+		engine = &Congress{config: config}
+		val    = common.HexToAddress("0x0a")
+		chain  testHeaderChain
+	)
+	// The epoch length is governed to 20 at block 32, so the next epochs are 40 and
+	// 60, then to 30 at block 60, so the next one is 90
+	governed, regoverned := defaultParams(config), defaultParams(config)
+	governed.Epoch, regoverned.Epoch = 20, 30
+	for i := 0; i <= 100; i++ {
+		header := &types.Header{Number: big.NewInt(int64(i)), Extra: epochExtra()}
+		switch i {
+		case 0, 16:
+			header.Extra = epochExtra(val)
+		case 32, 40:
+			header.Extra, header.MixDigest = epochExtra(val), governed.encode()
+		case 60, 90:
+			header.Extra, header.MixDigest = epochExtra(val), regoverned.encode()
+		}
+		chain = append(chain, header)
+	}
+	lookups := 0
+	getHeader := func(number uint64) (*types.Header, error) {
+		lookups++
+		return chain.GetHeaderByNumber(number), nil
+	}
+	tests := []struct {
+		number  uint64
+		start   uint64 // Epoch length in effect at the checkpoint, if known
+		epoch   uint64
+		lookups int
+	}{
+		{20, 0, 16, 1},
+		{35, 0, 32, 1}, // the governed length only applies from its next multiple
+		{62, 0, 60, 3}, // the multiples of the configured length are no epochs anymore
+		{95, 0, 90, 9}, // the length was governed twice since the first epoch found
+		{95, 30, 90, 1},
+		{99, 20, 90, 3},
+	}
+	for _, tt := range tests {
+		lookups = 0
+		header, err := engine.LatestEpoch(tt.number, tt.start, getHeader)
+		if err != nil {
+			t.Fatalf("latest epoch of #%d: %v", tt.number, err)
+		}
+		if header.Number.Uint64() != tt.epoch {
+			t.Errorf("latest epoch of #%d: have #%d, want #%d", tt.number, header.Number, tt.epoch)
+		}
+		if lookups != tt.lookups {
+			t.Errorf("latest epoch of #%d: header lookups mismatch: have %d, want %d", tt.number, lookups, tt.lookups)
+		}
+	}
+	// Without a known length, the one governed by the last trusted epoch is used
+	engine.db, engine.recents = rawdb.NewMemoryDatabase(), mustNewARC(8)
+	if err := engine.AddTrustedEpoch(chain[60]); err != nil {
+		t.Fatalf("failed to trust epoch: %v", err)
+	}
+	lookups = 0
+	header, err := engine.LatestEpoch(95, 0, getHeader)
+	if err != nil {
+		t.Fatalf("latest epoch from the trusted length: %v", err)
+	}
+	if header.Number.Uint64() != 90 || lookups != 1 {
+		t.Errorf("latest epoch from the trusted length mismatch: have #%d in %d lookups, want #90 in 1", header.Number, lookups)
+	}
+}
+
+func TestTrustedEpoch(t *testing.T) {
+	var (
+		config     = &params.CongressConfig{Epoch: 16}
+		engine     = &Congress{config: config, db: rawdb.NewMemoryDatabase(), recents: mustNewARC(8), signatures: mustNewARC(8)}
+		keys       = make([]*ecdsa.PrivateKey, 3)
+		vals       = make([]common.Address, 3)
+		outside, _ = crypto.GenerateKey()
+	)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		vals[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+
+	// The ancestors of the trusted epoch block are unknown
+	epoch := &types.Header{Number: big.NewInt(32), Difficulty: big.NewInt(1), Extra: epochExtra(vals...)}
+	if err := engine.AddTrustedEpoch(&types.Header{Number: big.NewInt(33), Extra: epochExtra()}); err == nil {
+		t.Fatalf("non-epoch block trusted")
+	}
+	if err := engine.AddTrustedEpoch(epoch); err != nil {
+		t.Fatalf("failed to trust epoch: %v", err)
+	}
+	seal := func(parent *types.Header, key *ecdsa.PrivateKey) *types.Header {
+		header := &types.Header{Number: new(big.Int).Add(parent.Number, common.Big1), ParentHash: parent.Hash(), Difficulty: big.NewInt(1), Extra: epochExtra()}
+		sig, err := crypto.Sign(SealHash(header).Bytes(), key)
+		if err != nil {
+			t.Fatalf("failed to seal header: %v", err)
+		}
+		copy(header.Extra[len(header.Extra)-extraSeal:], sig)
+		return header
+	}
+	h33 := seal(epoch, keys[0])
+	h34 := seal(h33, keys[1])
+	snap, err := engine.snapshot(testHeaderChain{}, 34, h34.Hash(), []*types.Header{h33, h34})
+	if err != nil {
+		t.Fatalf("failed to verify headers from trusted epoch: %v", err)
+	}
+	if snap.Recents[33] != vals[0] || snap.Recents[34] != vals[1] {
+		t.Fatalf("recent signers mismatch: %v", snap.Recents)
+	}
+	// Recent signers and outsiders are rejected
+	recent := seal(h34, keys[1])
+	if _, err := engine.snapshot(testHeaderChain{}, 35, recent.Hash(), []*types.Header{h33, h34, recent}); err != errRecentlySigned {
+		t.Errorf("recent signer error mismatch: have %v, want %v", err, errRecentlySigned)
+	}
+	h35 := seal(h34, outside)
+	if _, err := engine.snapshot(testHeaderChain{}, 35, h35.Hash(), []*types.Header{h33, h34, h35}); err != errUnauthorizedValidator {
+		t.Errorf("outsider error mismatch: have %v, want %v", err, errUnauthorizedValidator)
+	}
+}
@@ -0,0 +1,164 @@
+package congress
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// snapshotPrefix + hash -> snapshot
+//
+// The other records of the engine (reward ledger, policy history) are stored
+// under longer prefixes starting with this one, see IsSnapshotKey.
+var snapshotPrefix = []byte("congress-")
+
+// ErrEpochStateMissing is returned when the validators of an epoch can't be
+// re-derived, as the state of its parent block is not available.
+var ErrEpochStateMissing = errors.New("missing state of epoch parent")
+
+// IterateSnapshots calls fn with all snapshots stored in the database, in the
+// order of their block hashes, until fn returns false.
+func IterateSnapshots(db ethdb.Iteratee, fn func(*Snapshot) bool) error {
+	it := db.NewIterator(snapshotPrefix, nil)
+	defer it.Release()
+
+	for it.Next() {
+		if !IsSnapshotKey(it.Key()) {
+			continue
+		}
+		snap := new(Snapshot)
+		if err := json.Unmarshal(it.Value(), snap); err != nil {
+			continue
+		}
+		if !fn(snap) {
+			break
+		}
+	}
+	return it.Error()
+}
+
+// ReadSnapshot retrieves the snapshot stored for the given block.
+func ReadSnapshot(db ethdb.Database, hash common.Hash) (*Snapshot, error) {
+	return loadSnapshot(nil, nil, db, hash)
+}
+
+// DeleteSnapshot removes the snapshot stored for the given block.
+func DeleteSnapshot(db ethdb.KeyValueWriter, hash common.Hash) error {
+	return db.Delete(append(append([]byte{}, snapshotPrefix...), hash[:]...))
+}
+
+// RebuildSnapshots re-derives the snapshots of the canonical chain from the block
+// from up to the block to, storing them at every checkpoint like the engine does.
+// The derivation starts from the genesis validators, or from the snapshot stored
+// for the from block, which must be a checkpoint. It returns the number of stored
+// snapshots.
+func (c *Congress) RebuildSnapshots(chain consensus.ChainHeaderReader, from, to uint64) (int, error) {
+	var snap *Snapshot
+	if from == 0 {
+		genesis := chain.GetHeaderByNumber(0)
+		if genesis == nil {
+			return 0, errUnknownBlock
+		}
+		validators, err := EpochValidators(genesis)
+		if err != nil {
+			return 0, err
+		}
+		snap = newSnapshot(c.config, c.signatures, 0, genesis.Hash(), validators)
+	} else {
+		if from%CheckpointInterval != 0 {
+			return 0, fmt.Errorf("block %d is not a checkpoint (every %d blocks)", from, CheckpointInterval)
+		}
+		header := chain.GetHeaderByNumber(from)
+		if header == nil {
+			return 0, errUnknownBlock
+		}
+		s, err := loadSnapshot(c.config, c.signatures, c.db, header.Hash())
+		if err != nil {
+			return 0, fmt.Errorf("no snapshot stored for block %d: %v", from, err)
+		}
+		snap = s
+	}
+	if err := snap.store(c.db); err != nil {
+		return 0, err
+	}
+	stored := 1
+	for snap.Number < to {
+		// Apply the headers up to the next checkpoint at once
+		last := (snap.Number/CheckpointInterval + 1) * CheckpointInterval
+		if last > to {
+			last = to
+		}
+		headers := make([]*types.Header, 0, last-snap.Number)
+		for number := snap.Number + 1; number <= last; number++ {
+			header := chain.GetHeaderByNumber(number)
+			if header == nil {
+				return stored, fmt.Errorf("missing header %d", number)
+			}
+			headers = append(headers, header)
+		}
+		next, err := snap.apply(headers, chain, nil)
+		if err != nil {
+			return stored, fmt.Errorf("failed to apply headers %d-%d: %v", snap.Number+1, last, err)
+		}
+		snap = next
+		if snap.Number%CheckpointInterval == 0 {
+			if err := snap.store(c.db); err != nil {
+				return stored, err
+			}
+			stored++
+			log.Info("Rebuilt congress snapshot", "number", snap.Number, "hash", snap.Hash)
+		}
+	}
+	c.recents.Purge()
+	return stored, nil
+}
+
+// VerifyEpochValidators re-derives the validators and the consensus parameters
+// chosen at an epoch block from the state of its parent, and checks them against
+// the ones in the header.
+// It returns ErrEpochStateMissing if the state of the parent is not available.
+func (c *Congress) VerifyEpochValidators(chain consensus.ChainHeaderReader, header *types.Header) error {
+	isEpoch, cp, err := c.isEpoch(chain, header, nil)
+	if err != nil {
+		return err
+	}
+	if !isEpoch {
+		return errors.New("not an epoch block")
+	}
+	number := header.Number.Uint64()
+	parent := chain.GetHeader(header.ParentHash, number-1)
+	if parent == nil {
+		return consensus.ErrUnknownAncestor
+	}
+	if _, err := c.stateFn(parent.Root); err != nil {
+		return ErrEpochStateMissing
+	}
+	expected, err := c.getTopValidators(chain, header, cp)
+	if err != nil {
+		return err
+	}
+	validators, err := EpochValidators(header)
+	if err != nil {
+		return err
+	}
+	if digest, err := c.nextParams(chain, header); err != nil {
+		return err
+	} else if digest != header.MixDigest {
+		return fmt.Errorf("%w: have %x, want %x", errInvalidParams, header.MixDigest, digest)
+	}
+	if len(validators) != len(expected) {
+		return fmt.Errorf("validator count mismatch: have %d, want %d", len(validators), len(expected))
+	}
+	for i := range validators {
+		if validators[i] != expected[i] {
+			return fmt.Errorf("validator %d mismatch: have %s, want %s", i, validators[i].Hex(), expected[i].Hex())
+		}
+	}
+	return nil
+}
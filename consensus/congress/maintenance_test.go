@@ -0,0 +1,40 @@
+package congress
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+)
+
+func TestIterateSnapshots(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	for i := uint64(1); i <= 3; i++ {
+		snap := newSnapshot(nil, nil, i*CheckpointInterval, common.BigToHash(new(big.Int).SetUint64(i)), []common.Address{common.HexToAddress("0x01")})
+		if err := snap.store(db); err != nil {
+			t.Fatalf("failed to store snapshot: %v", err)
+		}
+	}
+	// Other records sharing the prefix must be skipped
+	if err := WriteRewardRecord(db, &RewardRecord{BlockNumber: 1, Fee: (*hexutil.Big)(common.Big1)}); err != nil {
+		t.Fatalf("failed to write reward record: %v", err)
+	}
+	if err := DeleteSnapshot(db, common.BigToHash(common.Big2)); err != nil {
+		t.Fatalf("failed to delete snapshot: %v", err)
+	}
+	var numbers []uint64
+	if err := IterateSnapshots(db, func(snap *Snapshot) bool {
+		numbers = append(numbers, snap.Number)
+		return true
+	}); err != nil {
+		t.Fatalf("failed to iterate snapshots: %v", err)
+	}
+	if len(numbers) != 2 || numbers[0] != CheckpointInterval || numbers[1] != 3*CheckpointInterval {
+		t.Fatalf("snapshots mismatch: have %v", numbers)
+	}
+	if snap, err := ReadSnapshot(db, common.BigToHash(common.Big3)); err != nil || len(snap.Validators) != 1 {
+		t.Fatalf("failed to read snapshot: %v, err %v", snap, err)
+	}
+}
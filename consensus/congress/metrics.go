@@ -0,0 +1,190 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package congress
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	metricsChanSize      = 10  // Size of the channel listening to chain events
+	maxValidatorCounters = 128 // Maximum number of per-validator counters kept registered
+)
+
+var (
+	sealInturnMeter     = metrics.NewRegisteredMeter("congress/seal/inturn", nil)
+	sealOutturnMeter    = metrics.NewRegisteredMeter("congress/seal/outturn", nil)
+	sealWiggleTimer     = metrics.NewRegisteredTimer("congress/seal/wiggle", nil)
+	sealRecentlyMeter   = metrics.NewRegisteredMeter("congress/seal/recently", nil)
+	recentsHitMeter     = metrics.NewRegisteredMeter("congress/cache/recents/hit", nil)
+	recentsMissMeter    = metrics.NewRegisteredMeter("congress/cache/recents/miss", nil)
+	signaturesHitMeter  = metrics.NewRegisteredMeter("congress/cache/signatures/hit", nil)
+	signaturesMissMeter = metrics.NewRegisteredMeter("congress/cache/signatures/miss", nil)
+
+	validatorsGauge        = metrics.NewRegisteredGauge("congress/epoch/validators", nil)
+	validatorsChangeMeter  = metrics.NewRegisteredMeter("congress/epoch/changes", nil)
+	validatorsAddedMeter   = metrics.NewRegisteredMeter("congress/epoch/added", nil)
+	validatorsRemovedMeter = metrics.NewRegisteredMeter("congress/epoch/removed", nil)
+	punishMeter            = metrics.NewRegisteredMeter("congress/punish", nil)
+	proposalExecutionMeter = metrics.NewRegisteredMeter("congress/proposal/executed", nil)
+
+	perValidatorCounters = newValidatorCounters(metrics.DefaultRegistry, maxValidatorCounters)
+)
+
+// validatorCounters keeps the per-validator counters registered, unregistering
+// the least recently used ones beyond a limit, so the counters of the validators
+// which left the set don't pile up.
+type validatorCounters struct {
+	registry metrics.Registry
+	names    *lru.Cache // Names of the registered counters
+}
+
+func newValidatorCounters(registry metrics.Registry, limit int) *validatorCounters {
+	names, _ := lru.NewWithEvict(limit, func(name, _ interface{}) {
+		registry.Unregister(name.(string))
+	})
+	return &validatorCounters{registry: registry, names: names}
+}
+
+// inc increments the counter of the given name, registering it if needed.
+func (vc *validatorCounters) inc(name string) {
+	metrics.GetOrRegisterCounter(name, vc.registry).Inc(1)
+	vc.names.Add(name, nil)
+}
+
+// markSeal counts a block sealed by the given validator, in-turn or out-of-turn.
+func markSeal(validator common.Address, inturn bool) {
+	turn := "outturn"
+	if inturn {
+		sealInturnMeter.Mark(1)
+		turn = "inturn"
+	} else {
+		sealOutturnMeter.Mark(1)
+	}
+	perValidatorCounters.inc(fmt.Sprintf("congress/seal/%s/%s", turn, validator.Hex()))
+}
+
+// markPunish counts a punishment of the given validator for missing its turn.
+func markPunish(validator common.Address) {
+	punishMeter.Mark(1)
+	perValidatorCounters.inc(fmt.Sprintf("congress/punish/%s", validator.Hex()))
+}
+
+// markValidatorSet records the validator set chosen at an epoch block, and its
+// change if any.
+func markValidatorSet(validators int, change *ValidatorSetChange) {
+	validatorsGauge.Update(int64(validators))
+
+	if change != nil {
+		validatorsChangeMeter.Mark(1)
+		validatorsAddedMeter.Mark(int64(len(change.Added)))
+		validatorsRemovedMeter.Mark(int64(len(change.Removed)))
+	}
+}
+
+// markBlock counts the seal, the punishment and the executed proposals of a
+// canonical block. The punished validator is derived from the parent snapshot by
+// the same missed-turn rule as Finalize.
+func (c *Congress) markBlock(chain consensus.ChainHeaderReader, block *types.Block) {
+	header := block.Header()
+	markSeal(header.Coinbase, IsInTurn(header))
+
+	if number := header.Number.Uint64(); number > 0 && header.Difficulty.Cmp(diffInTurn) != 0 {
+		snap, err := c.snapshot(chain, number-1, header.ParentHash, nil)
+		if err != nil {
+			log.Debug("Failed to get snapshot for punish metrics", "number", number, "err", err)
+		} else if missed, ok := snap.missedValidator(number); ok {
+			markPunish(missed)
+		}
+	}
+	for _, tx := range block.Transactions() {
+		if to := tx.To(); to == nil || *to != systemcontract.SysGovToAddr {
+			continue
+		}
+		sender, err := types.Sender(c.signer, tx)
+		if err != nil {
+			continue
+		}
+		if sys, _ := c.IsSysTransaction(sender, tx, header); sys {
+			proposalExecutionMeter.Mark(1)
+		}
+	}
+}
+
+// metricsChain is the part of the blockchain the block metrics follow.
+type metricsChain interface {
+	consensus.ChainHeaderReader
+	SubscribeChainEvent(ch chan<- core.ChainEvent) event.Subscription
+}
+
+// blockMetrics counts the seals, the punishments and the executed proposals of
+// the blocks as they are inserted into the canonical chain, so every block is counted once, however
+// many times it's finalized or its snapshot is rebuilt.
+type blockMetrics struct {
+	sub  event.Subscription
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// StartBlockMetrics starts counting the blocks inserted into the given chain. It
+// does nothing if metrics collection is disabled.
+func (c *Congress) StartBlockMetrics(chain metricsChain) {
+	if !metrics.Enabled {
+		return
+	}
+	m := &blockMetrics{quit: make(chan struct{})}
+	events := make(chan core.ChainEvent, metricsChanSize)
+	m.sub = chain.SubscribeChainEvent(events)
+
+	m.wg.Add(1)
+	go m.loop(c, chain, events)
+	c.blockMetrics = m
+}
+
+func (m *blockMetrics) loop(c *Congress, chain consensus.ChainHeaderReader, events chan core.ChainEvent) {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case ev := <-events:
+			if ev.Block != nil {
+				c.markBlock(chain, ev.Block)
+			}
+		case <-m.sub.Err():
+			return
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+func (m *blockMetrics) close() {
+	m.sub.Unsubscribe()
+	close(m.quit)
+	m.wg.Wait()
+}
@@ -0,0 +1,139 @@
+package congress
+
+import (
+	"math/big"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// enableMetrics switches metrics collection on and registers the per-validator
+// counters in a fresh registry for the duration of the test.
+func enableMetrics(t *testing.T, limit int) metrics.Registry {
+	enabled, counters, proposals := metrics.Enabled, perValidatorCounters, proposalExecutionMeter
+	registry := metrics.NewRegistry()
+	metrics.Enabled, perValidatorCounters = true, newValidatorCounters(registry, limit)
+	proposalExecutionMeter = metrics.NewMeter()
+	t.Cleanup(func() {
+		proposalExecutionMeter.Stop()
+		metrics.Enabled, perValidatorCounters, proposalExecutionMeter = enabled, counters, proposals
+	})
+	return registry
+}
+
+func counterValue(registry metrics.Registry, name string) int64 {
+	if counter, ok := registry.Get(name).(metrics.Counter); ok {
+		return counter.Count()
+	}
+	return -1
+}
+
+func TestValidatorCountersLimit(t *testing.T) {
+	var (
+		registry = enableMetrics(t, 2)
+		a, b, c  = common.HexToAddress("0x0a"), common.HexToAddress("0x0b"), common.HexToAddress("0x0c")
+	)
+	markPunish(a)
+	markPunish(a)
+	if n := counterValue(registry, "congress/punish/"+a.Hex()); n != 2 {
+		t.Fatalf("punish counter mismatch: have %d, want 2", n)
+	}
+	markPunish(b)
+	markPunish(c)
+	if n := counterValue(registry, "congress/punish/"+a.Hex()); n != -1 {
+		t.Fatalf("least recent counter not unregistered: %d", n)
+	}
+	if counterValue(registry, "congress/punish/"+b.Hex()) != 1 || counterValue(registry, "congress/punish/"+c.Hex()) != 1 {
+		t.Fatalf("recent counters mismatch")
+	}
+}
+
+type testChainEvents struct {
+	testHeaderChain
+	feed event.Feed
+}
+
+func (c *testChainEvents) SubscribeChainEvent(ch chan<- core.ChainEvent) event.Subscription {
+	return c.feed.Subscribe(ch)
+}
+
+func TestBlockMetrics(t *testing.T) {
+	var (
+		registry   = enableMetrics(t, 8)
+		config     = &params.CongressConfig{Epoch: 100}
+		key, _     = crypto.GenerateKey()
+		userKey, _ = crypto.GenerateKey()
+		validator  = crypto.PubkeyToAddress(key.PublicKey)
+		other      = common.HexToAddress("0x0b")
+		sorted     = []common.Address{validator, other}
+		genesis    = &types.Header{Number: big.NewInt(0), Extra: epochExtra(validator, other)}
+		signer     = types.LatestSignerForChainID(params.TestChainConfig.ChainID)
+		chain      = &testChainEvents{testHeaderChain: testHeaderChain{genesis}}
+	)
+	sort.Sort(validatorsAscending(sorted))
+
+	// The validator seals both blocks, the second one in place of the other one
+	var blocks []*types.Block
+	for parent := genesis; len(blocks) < 2; parent = blocks[len(blocks)-1].Header() {
+		number := new(big.Int).Add(parent.Number, common.Big1)
+		header := &types.Header{Number: number, ParentHash: parent.Hash(), Coinbase: validator, Difficulty: diffNoTurn, Extra: epochExtra()}
+		if sorted[number.Uint64()%2] == validator {
+			header.Difficulty = diffInTurn
+		}
+		sig, err := crypto.Sign(SealHash(header).Bytes(), key)
+		if err != nil {
+			t.Fatalf("failed to seal header: %v", err)
+		}
+		copy(header.Extra[len(header.Extra)-extraSeal:], sig)
+		chain.testHeaderChain = append(chain.testHeaderChain, header)
+		blocks = append(blocks, types.NewBlockWithHeader(header))
+	}
+	// Only the governance transactions of the miner are executed proposals
+	sysTx, _ := types.SignTx(types.NewTransaction(0, systemcontract.SysGovToAddr, new(big.Int), 100000, new(big.Int), nil), signer, key)
+	userTx, _ := types.SignTx(types.NewTransaction(0, systemcontract.SysGovToAddr, new(big.Int), 100000, big.NewInt(1), nil), signer, userKey)
+	blocks[1] = blocks[1].WithBody([]*types.Transaction{sysTx, userTx}, nil)
+
+	// Rebuilding snapshots doesn't count the blocks
+	headers := []*types.Header{blocks[0].Header(), blocks[1].Header()}
+	for i := 0; i < 2; i++ {
+		snap := newSnapshot(config, mustNewARC(8), 0, genesis.Hash(), sorted)
+		if _, err := snap.apply(headers[:1], nil, nil); err != nil {
+			t.Fatalf("failed to apply headers: %v", err)
+		}
+	}
+	if n := counterValue(registry, "congress/seal/inturn/"+validator.Hex()); n != -1 {
+		t.Fatalf("snapshot rebuild counted seals: %d", n)
+	}
+	// Inserting the blocks does, once
+	engine := &Congress{config: config, recents: mustNewARC(8), signatures: mustNewARC(8), signer: signer}
+	engine.recents.Add(genesis.Hash(), newSnapshot(config, engine.signatures, 0, genesis.Hash(), sorted))
+	engine.StartBlockMetrics(chain)
+	defer engine.blockMetrics.close()
+
+	for _, block := range blocks {
+		chain.feed.Send(core.ChainEvent{Block: block, Hash: block.Hash()})
+	}
+	counted := func() bool {
+		return counterValue(registry, "congress/seal/inturn/"+validator.Hex()) == 1 &&
+			counterValue(registry, "congress/seal/outturn/"+validator.Hex()) == 1 &&
+			counterValue(registry, "congress/punish/"+other.Hex()) == 1 &&
+			proposalExecutionMeter.Count() == 1
+	}
+	for deadline := time.Now().Add(time.Second); !counted(); {
+		if time.Now().After(deadline) {
+			t.Fatalf("block metrics mismatch: inturn %d, outturn %d, punish %d, proposals %d",
+				counterValue(registry, "congress/seal/inturn/"+validator.Hex()), counterValue(registry, "congress/seal/outturn/"+validator.Hex()),
+				counterValue(registry, "congress/punish/"+other.Hex()), proposalExecutionMeter.Count())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,148 @@
+package congress
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/netutil"
+)
+
+// Storage slots of the sets of the node allowlist store. A set keeps its length
+// at its base slot and its entries from the slot at the hash of the base slot on,
+// like a solidity dynamic array, and the position (plus one) of each entry at the
+// hash of the entry and the base slot, like a solidity mapping.
+var (
+	nodeListIDsSlot  = common.BigToHash(big.NewInt(1))
+	nodeListNetsSlot = common.BigToHash(big.NewInt(2))
+)
+
+// storeSetEntries returns the entries of a set kept in the storage of a store.
+func storeSetEntries(statedb *state.StateDB, addr common.Address, base common.Hash) []common.Hash {
+	var (
+		length  = statedb.GetState(addr, base).Big().Uint64()
+		first   = crypto.Keccak256Hash(base[:]).Big()
+		entries = make([]common.Hash, 0, length)
+	)
+	for i := uint64(0); i < length; i++ {
+		slot := common.BigToHash(new(big.Int).Add(first, new(big.Int).SetUint64(i)))
+		entries = append(entries, statedb.GetState(addr, slot))
+	}
+	return entries
+}
+
+// storeSetUpdate adds an entry to or removes it from a set kept in the storage of
+// a store, the last entry taking the place of a removed one.
+func storeSetUpdate(statedb *state.StateDB, addr common.Address, base common.Hash, entry common.Hash, add bool) {
+	var (
+		length   = statedb.GetState(addr, base).Big().Uint64()
+		first    = crypto.Keccak256Hash(base[:]).Big()
+		indexKey = crypto.Keccak256Hash(entry[:], base[:])
+		index    = statedb.GetState(addr, indexKey).Big().Uint64()
+		slotAt   = func(i uint64) common.Hash {
+			return common.BigToHash(new(big.Int).Add(first, new(big.Int).SetUint64(i)))
+		}
+	)
+	switch {
+	case add && index == 0:
+		statedb.SetState(addr, slotAt(length), entry)
+		statedb.SetState(addr, indexKey, common.BigToHash(new(big.Int).SetUint64(length+1)))
+		statedb.SetState(addr, base, common.BigToHash(new(big.Int).SetUint64(length+1)))
+
+	case !add && index != 0:
+		if index != length {
+			last := statedb.GetState(addr, slotAt(length-1))
+			statedb.SetState(addr, slotAt(index-1), last)
+			statedb.SetState(addr, crypto.Keccak256Hash(last[:], base[:]), common.BigToHash(new(big.Int).SetUint64(index)))
+		}
+		statedb.SetState(addr, slotAt(length-1), common.Hash{})
+		statedb.SetState(addr, indexKey, common.Hash{})
+		statedb.SetState(addr, base, common.BigToHash(new(big.Int).SetUint64(length-1)))
+	}
+}
+
+// encodeNodeListNet packs an IP network into a set entry: the 16 bytes IP
+// followed by the prefix length within the IPv4 or IPv6 address.
+func encodeNodeListNet(ip [16]byte, bits uint8) (common.Hash, error) {
+	addr := net.IP(ip[:])
+	size := 8 * net.IPv6len
+	if addr.To4() != nil {
+		size = 8 * net.IPv4len
+	}
+	if int(bits) > size {
+		return common.Hash{}, fmt.Errorf("prefix length %d above %d", bits, size)
+	}
+	var entry common.Hash
+	copy(entry[:], addr.Mask(net.CIDRMask(int(bits)+8*net.IPv6len-size, 8*net.IPv6len)))
+	entry[net.IPv6len] = bits
+	return entry, nil
+}
+
+// decodeNodeListNet unpacks an IP network of a set entry.
+func decodeNodeListNet(entry common.Hash) net.IPNet {
+	ip, bits := net.IP(common.CopyBytes(entry[:net.IPv6len])), int(entry[net.IPv6len])
+	if ip4 := ip.To4(); ip4 != nil {
+		return net.IPNet{IP: ip4, Mask: net.CIDRMask(bits, 8*net.IPv4len)}
+	}
+	return net.IPNet{IP: ip, Mask: net.CIDRMask(bits, 8*net.IPv6len)}
+}
+
+// ReadNodeAllowlist reads the node IDs and the IP networks allowed to peer from
+// the node allowlist store at the given state.
+func ReadNodeAllowlist(statedb *state.StateDB) ([]enode.ID, netutil.Netlist) {
+	var (
+		ids  []enode.ID
+		nets netutil.Netlist
+	)
+	for _, entry := range storeSetEntries(statedb, systemcontract.NodeListContractAddr, nodeListIDsSlot) {
+		ids = append(ids, enode.ID(entry))
+	}
+	for _, entry := range storeSetEntries(statedb, systemcontract.NodeListContractAddr, nodeListNetsSlot) {
+		nets = append(nets, decodeNodeListNet(entry))
+	}
+	return ids, nets
+}
+
+// NodeAllowlist reads the node IDs and the IP networks allowed to peer at the
+// given block.
+func (c *Congress) NodeAllowlist(header *types.Header) ([]enode.ID, netutil.Netlist, error) {
+	if c.stateFn == nil {
+		return nil, nil, errors.New("state unavailable")
+	}
+	statedb, err := c.stateFn(header.Root)
+	if err != nil {
+		return nil, nil, err
+	}
+	ids, nets := ReadNodeAllowlist(statedb)
+	return ids, nets, nil
+}
+
+// executeNodeListProposal executes a system governance proposal calling the
+// node allowlist store, allowing or disallowing a node ID or an IP network.
+func (c *Congress) executeNodeListProposal(state *state.StateDB, prop *Proposal) error {
+	method, args, err := unpackNativeProposal(c.abi[systemcontract.NodeListContractName], prop)
+	if err != nil {
+		return err
+	}
+	switch method {
+	case "setNode":
+		id, allowed := args[0].([32]byte), args[1].(bool)
+		storeSetUpdate(state, systemcontract.NodeListContractAddr, nodeListIDsSlot, common.Hash(id), allowed)
+	case "setNet":
+		ip, bits, allowed := args[0].([16]byte), args[1].(uint8), args[2].(bool)
+		entry, err := encodeNodeListNet(ip, bits)
+		if err != nil {
+			return err
+		}
+		storeSetUpdate(state, systemcontract.NodeListContractAddr, nodeListNetsSlot, entry, allowed)
+	}
+	keepNativeStore(state, systemcontract.NodeListContractAddr)
+	return nil
+}
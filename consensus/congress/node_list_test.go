@@ -0,0 +1,103 @@
+package congress
+
+import (
+	"math/big"
+	"net"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestExecuteNodeListProposal(t *testing.T) {
+	var (
+		engine  = &Congress{abi: systemcontract.GetInteractiveABI()}
+		statedb = mustNewState(t)
+		listABI = engine.abi[systemcontract.NodeListContractName]
+		execute = func(method string, args ...interface{}) error {
+			data, err := listABI.Pack(method, args...)
+			if err != nil {
+				t.Fatalf("failed to pack proposal: %v", err)
+			}
+			return engine.executeNodeListProposal(statedb, &Proposal{To: systemcontract.NodeListContractAddr, Value: new(big.Int), Data: data})
+		}
+		ipv4 = func(s string) (ip [16]byte) {
+			copy(ip[:], net.ParseIP(s).To16())
+			return ip
+		}
+		a, b, c = enode.ID{0x0a}, enode.ID{0x0b}, enode.ID{0x0c}
+	)
+	for _, id := range []enode.ID{a, b, c} {
+		if err := execute("setNode", [32]byte(id), true); err != nil {
+			t.Fatalf("failed to allow node: %v", err)
+		}
+	}
+	// Removing an entry moves the last one in its place
+	if err := execute("setNode", [32]byte(a), false); err != nil {
+		t.Fatalf("failed to disallow node: %v", err)
+	}
+	if err := execute("setNet", ipv4("10.1.2.3"), uint8(8), true); err != nil {
+		t.Fatalf("failed to allow network: %v", err)
+	}
+	if err := execute("setNet", ipv4("10.0.0.0"), uint8(33), true); err == nil {
+		t.Fatalf("invalid prefix length accepted")
+	}
+	ids, nets := ReadNodeAllowlist(statedb)
+	if len(ids) != 2 || ids[0] != c || ids[1] != b {
+		t.Fatalf("allowed nodes mismatch: %v", ids)
+	}
+	if len(nets) != 1 || nets[0].String() != "10.0.0.0/8" {
+		t.Fatalf("allowed networks mismatch: %v", nets)
+	}
+	// The network is kept in its canonical form, so it can be removed by any of its IPs
+	if err := execute("setNet", ipv4("10.9.9.9"), uint8(8), false); err != nil {
+		t.Fatalf("failed to disallow network: %v", err)
+	}
+	if _, nets := ReadNodeAllowlist(statedb); len(nets) != 0 {
+		t.Fatalf("disallowed network kept: %v", nets)
+	}
+	if statedb.GetNonce(systemcontract.NodeListContractAddr) == 0 {
+		t.Fatalf("store left as an empty account")
+	}
+	if err := engine.executeNativeProposal(statedb, &Proposal{To: common.HexToAddress("0x01"), Value: new(big.Int)}); err == nil {
+		t.Fatalf("proposal to a contract executed natively")
+	}
+}
+
+func TestNodeListGovernanceFork(t *testing.T) {
+	var (
+		config      = &params.CongressConfig{Period: 3, Epoch: 200, GovernanceBlock: big.NewInt(10)}
+		chainConfig = *params.TestChainConfig
+		engine      = &Congress{config: config, abi: systemcontract.GetInteractiveABI()}
+	)
+	chainConfig.Congress = config
+	engine.chainConfig = &chainConfig
+
+	data, err := engine.abi[systemcontract.NodeListContractName].Pack("setNode", [32]byte{0x0a}, true)
+	if err != nil {
+		t.Fatalf("failed to pack proposal: %v", err)
+	}
+	prop := &Proposal{Id: big.NewInt(1), Action: new(big.Int), From: common.HexToAddress("0x01"), To: systemcontract.NodeListContractAddr, Value: new(big.Int), Data: data}
+
+	for _, tt := range []struct {
+		number int64
+		nodes  int
+	}{
+		{9, 0}, // before the fork, the proposal is a plain call to a code-less account
+		{10, 1},
+	} {
+		var (
+			statedb = mustNewState(t)
+			header  = &types.Header{Number: big.NewInt(tt.number), Difficulty: diffInTurn, GasLimit: 8000000}
+		)
+		if receipt := engine.executeProposalMsg(nil, header, statedb, prop, 0, common.Hash{}, common.Hash{}); receipt.Status != types.ReceiptStatusSuccessful {
+			t.Errorf("block %d: proposal failed", tt.number)
+		}
+		if ids, _ := ReadNodeAllowlist(statedb); len(ids) != tt.nodes {
+			t.Errorf("block %d: allowed nodes mismatch: have %d, want %d", tt.number, len(ids), tt.nodes)
+		}
+	}
+}
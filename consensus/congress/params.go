@@ -0,0 +1,250 @@
+package congress
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Keys of the consensus parameters in the parameter store, each value is kept
+// at the storage slot of its key.
+const (
+	ParamPeriod        uint8 = iota + 1 // Number of seconds between blocks
+	ParamEpoch                          // Number of blocks of an epoch
+	ParamMaxValidators                  // Maximum number of active validators
+	ParamWiggleTime                     // Random delay of out-of-turn validators, in milliseconds
+	ParamGasLimit                       // Gas limit targeted by the validators, 0 to let the miners choose
+)
+
+const (
+	paramsVersion   = 0x01        // Version of the consensus parameter encoding in the mix digest
+	minParamsEpoch  = 16          // Minimum epoch length to keep the recents window and checkpoints meaningful
+	maxParamsWiggle = time.Minute // Maximum wiggle time, more would stall the out-of-turn blocks
+)
+
+// errInvalidParams is returned if the consensus parameters of an epoch block are
+// not the ones of the parameter store.
+var errInvalidParams = errors.New("invalid consensus parameters")
+
+// Params are the consensus parameters in effect for a block. The parameters of
+// the genesis configuration and the engine are in effect until a system governance
+// proposal changes them in the parameter store, which is only possible from the
+// governance fork on. The values of the store are read
+// at every epoch block, carried by its mix digest, and take effect from the next
+// block on. A new epoch length applies to the next multiple of it.
+type Params struct {
+	Period        uint64        `json:"period"`
+	Epoch         uint64        `json:"epoch"`
+	MaxValidators uint64        `json:"maxValidators"`
+	WiggleTime    time.Duration `json:"wiggleTime"`
+	GasLimit      uint64        `json:"gasLimit,omitempty"`
+}
+
+// defaultParams returns the consensus parameters in effect without governance.
+func defaultParams(config *params.CongressConfig) *Params {
+	return &Params{
+		Period:        config.Period,
+		Epoch:         config.Epoch,
+		MaxValidators: maxValidators,
+		WiggleTime:    wiggleTime,
+	}
+}
+
+// validate checks the consensus parameters are usable by the engine.
+func (p *Params) validate() error {
+	if p.Epoch < minParamsEpoch {
+		return fmt.Errorf("epoch %d below %d", p.Epoch, minParamsEpoch)
+	}
+	if p.MaxValidators == 0 {
+		return errors.New("no validators allowed")
+	}
+	if p.WiggleTime == 0 || p.WiggleTime > maxParamsWiggle {
+		return fmt.Errorf("wiggle time %v not in (0, %v]", p.WiggleTime, maxParamsWiggle)
+	}
+	return nil
+}
+
+// encode packs the consensus parameters into the mix digest of an epoch block.
+func (p *Params) encode() common.Hash {
+	var digest common.Hash
+	digest[0] = paramsVersion
+	binary.BigEndian.PutUint32(digest[1:], uint32(p.Period))
+	binary.BigEndian.PutUint64(digest[5:], p.Epoch)
+	binary.BigEndian.PutUint32(digest[13:], uint32(p.MaxValidators))
+	binary.BigEndian.PutUint32(digest[17:], uint32(p.WiggleTime/time.Millisecond))
+	binary.BigEndian.PutUint64(digest[21:], p.GasLimit)
+	return digest
+}
+
+// decodeParams unpacks the consensus parameters of an epoch block mix digest, it
+// returns nil if the digest is empty, that is no parameter is governed.
+func decodeParams(digest common.Hash) (*Params, error) {
+	if digest == (common.Hash{}) {
+		return nil, nil
+	}
+	if digest[0] != paramsVersion {
+		return nil, errInvalidMixDigest
+	}
+	for _, b := range digest[29:] {
+		if b != 0 {
+			return nil, errInvalidMixDigest
+		}
+	}
+	p := &Params{
+		Period:        uint64(binary.BigEndian.Uint32(digest[1:])),
+		Epoch:         binary.BigEndian.Uint64(digest[5:]),
+		MaxValidators: uint64(binary.BigEndian.Uint32(digest[13:])),
+		WiggleTime:    time.Duration(binary.BigEndian.Uint32(digest[17:])) * time.Millisecond,
+		GasLimit:      binary.BigEndian.Uint64(digest[21:]),
+	}
+	if err := p.validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidMixDigest, err)
+	}
+	return p, nil
+}
+
+// readStoredParams reads the consensus parameters of the parameter store, the
+// parameters never set keep their defaults. It returns nil if no parameter is
+// governed.
+func readStoredParams(config *params.CongressConfig, statedb *state.StateDB) *Params {
+	var (
+		p        = defaultParams(config)
+		governed bool
+	)
+	for _, key := range []uint8{ParamPeriod, ParamEpoch, ParamMaxValidators, ParamWiggleTime, ParamGasLimit} {
+		value := statedb.GetState(systemcontract.ConsensusParamsContractAddr, common.BytesToHash([]byte{key})).Big()
+		if value.Sign() == 0 {
+			continue
+		}
+		governed = true
+		switch key {
+		case ParamPeriod:
+			p.Period = value.Uint64()
+		case ParamEpoch:
+			p.Epoch = value.Uint64()
+		case ParamMaxValidators:
+			p.MaxValidators = value.Uint64()
+		case ParamWiggleTime:
+			p.WiggleTime = time.Duration(value.Uint64()) * time.Millisecond
+		case ParamGasLimit:
+			p.GasLimit = value.Uint64()
+		}
+	}
+	if !governed {
+		return nil
+	}
+	return p
+}
+
+// nextParams returns the mix digest of an epoch block, carrying the consensus
+// parameters of the store at its parent. It is empty before the governance fork.
+func (c *Congress) nextParams(chain consensus.ChainHeaderReader, header *types.Header) (common.Hash, error) {
+	if !c.config.IsGovernance(header.Number) {
+		return common.Hash{}, nil
+	}
+	parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	if parent == nil {
+		return common.Hash{}, consensus.ErrUnknownAncestor
+	}
+	statedb, err := c.stateFn(parent.Root)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	p := readStoredParams(c.config, statedb)
+	if p == nil {
+		return common.Hash{}, nil
+	}
+	if err := p.validate(); err != nil {
+		// Can't happen as the store only accepts valid values, but don't stall the chain
+		log.Error("Invalid stored consensus parameters", "err", err)
+		return common.Hash{}, nil
+	}
+	return p.encode(), nil
+}
+
+// epochParams returns the consensus parameters taking effect after the given
+// epoch block.
+func (c *Congress) epochParams(header *types.Header) *Params {
+	if !c.config.IsGovernance(header.Number) {
+		return defaultParams(c.config)
+	}
+	if p, err := decodeParams(header.MixDigest); err == nil && p != nil {
+		return p
+	}
+	return defaultParams(c.config)
+}
+
+// Params returns the consensus parameters in effect for the block after the
+// given one.
+func (c *Congress) Params(chain consensus.ChainHeaderReader, header *types.Header) (*Params, error) {
+	snap, err := c.snapshot(chain, header.Number.Uint64(), header.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return snap.params(), nil
+}
+
+// isEpoch tells whether the given block is an epoch block.
+func (c *Congress) isEpoch(chain consensus.ChainHeaderReader, header *types.Header, parents []*types.Header) (bool, *Params, error) {
+	number := header.Number.Uint64()
+	if number == 0 {
+		return false, defaultParams(c.config), nil
+	}
+	snap, err := c.snapshot(chain, number-1, header.ParentHash, parents)
+	if err != nil {
+		return false, nil, err
+	}
+	p := snap.params()
+	return number%p.Epoch == 0, p, nil
+}
+
+// executeParamsProposal executes a system governance proposal calling the
+// parameter store, setting the value of one consensus parameter.
+func (c *Congress) executeParamsProposal(state *state.StateDB, prop *Proposal) error {
+	_, args, err := unpackNativeProposal(c.abi[systemcontract.ConsensusParamsContractName], prop)
+	if err != nil {
+		return err
+	}
+	key, value := args[0].(uint8), args[1].(*big.Int)
+
+	// Check the new parameters as a whole
+	p := readStoredParams(c.config, state)
+	if p == nil {
+		p = defaultParams(c.config)
+	}
+	if !value.IsUint64() || (value.Uint64() > 1<<32-1 && key != ParamEpoch && key != ParamGasLimit) {
+		return fmt.Errorf("value %v out of range", value)
+	}
+	switch key {
+	case ParamPeriod:
+		p.Period = value.Uint64()
+	case ParamEpoch:
+		p.Epoch = value.Uint64()
+	case ParamMaxValidators:
+		p.MaxValidators = value.Uint64()
+	case ParamWiggleTime:
+		p.WiggleTime = time.Duration(value.Uint64()) * time.Millisecond
+	case ParamGasLimit:
+		p.GasLimit = value.Uint64()
+	default:
+		return fmt.Errorf("unknown consensus parameter %d", key)
+	}
+	if value.Sign() != 0 {
+		if err := p.validate(); err != nil {
+			return err
+		}
+	}
+	state.SetState(systemcontract.ConsensusParamsContractAddr, common.BytesToHash([]byte{key}), common.BigToHash(value))
+	keepNativeStore(state, systemcontract.ConsensusParamsContractAddr)
+	return nil
+}
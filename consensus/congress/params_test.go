@@ -0,0 +1,172 @@
+package congress
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestParamsEncoding(t *testing.T) {
+	p := &Params{Period: 3, Epoch: 200, MaxValidators: 15, WiggleTime: 750 * time.Millisecond, GasLimit: 30000000}
+	decoded, err := decodeParams(p.encode())
+	if err != nil {
+		t.Fatalf("failed to decode params: %v", err)
+	}
+	if *decoded != *p {
+		t.Fatalf("params mismatch: have %+v, want %+v", decoded, p)
+	}
+	if decoded, err := decodeParams(common.Hash{}); decoded != nil || err != nil {
+		t.Fatalf("empty digest decoded: %+v, err %v", decoded, err)
+	}
+	digest := p.encode()
+	digest[0] = 0x02
+	if _, err := decodeParams(digest); !errors.Is(err, errInvalidMixDigest) {
+		t.Fatalf("unknown version accepted: %v", err)
+	}
+	invalid := &Params{Period: 3, Epoch: 4, MaxValidators: 15}
+	if _, err := decodeParams(invalid.encode()); !errors.Is(err, errInvalidMixDigest) {
+		t.Fatalf("invalid params accepted: %v", err)
+	}
+}
+
+func TestExecuteParamsProposal(t *testing.T) {
+	var (
+		config    = &params.CongressConfig{Period: 3, Epoch: 200}
+		engine    = &Congress{config: config, abi: systemcontract.GetInteractiveABI()}
+		statedb   = mustNewState(t)
+		store     = systemcontract.ConsensusParamsContractAddr
+		storeABI  = engine.abi[systemcontract.ConsensusParamsContractName]
+		setParams = func(key uint8, value int64) error {
+			data, err := storeABI.Pack("setParam", key, big.NewInt(value))
+			if err != nil {
+				t.Fatalf("failed to pack proposal: %v", err)
+			}
+			return engine.executeParamsProposal(statedb, &Proposal{To: store, Value: new(big.Int), Data: data})
+		}
+	)
+	if p := readStoredParams(config, statedb); p != nil {
+		t.Fatalf("empty store governs params: %+v", p)
+	}
+	if err := setParams(ParamEpoch, 8); err == nil {
+		t.Fatalf("too short epoch accepted")
+	}
+	if err := setParams(99, 1); err == nil {
+		t.Fatalf("unknown parameter accepted")
+	}
+	if err := setParams(ParamEpoch, 100); err != nil {
+		t.Fatalf("failed to set epoch: %v", err)
+	}
+	if err := setParams(ParamWiggleTime, 250); err != nil {
+		t.Fatalf("failed to set wiggle time: %v", err)
+	}
+	want := &Params{Period: 3, Epoch: 100, MaxValidators: maxValidators, WiggleTime: 250 * time.Millisecond}
+	if p := readStoredParams(config, statedb); p == nil || *p != *want {
+		t.Fatalf("stored params mismatch: have %+v, want %+v", p, want)
+	}
+	// Resetting a parameter restores its default
+	if err := setParams(ParamEpoch, 0); err != nil {
+		t.Fatalf("failed to reset epoch: %v", err)
+	}
+	if p := readStoredParams(config, statedb); p == nil || p.Epoch != config.Epoch {
+		t.Fatalf("reset epoch mismatch: %+v", p)
+	}
+}
+
+func TestParamsGovernanceFork(t *testing.T) {
+	var (
+		config      = &params.CongressConfig{Period: 3, Epoch: 200, GovernanceBlock: big.NewInt(10)}
+		chainConfig = *params.TestChainConfig
+		engine      = &Congress{config: config, abi: systemcontract.GetInteractiveABI()}
+		store       = systemcontract.ConsensusParamsContractAddr
+	)
+	chainConfig.Congress = config
+	engine.chainConfig = &chainConfig
+
+	data, err := engine.abi[systemcontract.ConsensusParamsContractName].Pack("setParam", ParamEpoch, big.NewInt(100))
+	if err != nil {
+		t.Fatalf("failed to pack proposal: %v", err)
+	}
+	prop := &Proposal{Id: big.NewInt(1), Action: new(big.Int), From: common.HexToAddress("0x01"), To: store, Value: new(big.Int), Data: data}
+
+	for _, tt := range []struct {
+		number int64
+		stored bool
+	}{
+		{9, false}, // before the fork, the proposal is a plain call to a code-less account
+		{10, true},
+	} {
+		var (
+			statedb = mustNewState(t)
+			header  = &types.Header{Number: big.NewInt(tt.number), Difficulty: diffInTurn, GasLimit: 8000000}
+		)
+		receipt := engine.executeProposalMsg(nil, header, statedb, prop, 0, common.Hash{}, common.Hash{})
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			t.Errorf("block %d: proposal failed", tt.number)
+		}
+		if p := readStoredParams(config, statedb); (p != nil) != tt.stored {
+			t.Errorf("block %d: stored params mismatch: have %+v, want stored %v", tt.number, p, tt.stored)
+		}
+		if exist := statedb.Exist(store); exist != tt.stored {
+			t.Errorf("block %d: store existence mismatch: have %v, want %v", tt.number, exist, tt.stored)
+		}
+	}
+	// Epoch blocks carry no parameters before the fork, whatever the store holds
+	digest, err := engine.nextParams(nil, &types.Header{Number: big.NewInt(9)})
+	if err != nil || digest != (common.Hash{}) {
+		t.Errorf("pre-fork epoch block carries params: %x, err %v", digest, err)
+	}
+}
+
+func mustNewState(t *testing.T) *state.StateDB {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		t.Fatalf("failed to create state: %v", err)
+	}
+	return statedb
+}
+
+func TestGovernedMaxValidators(t *testing.T) {
+	var validators []common.Address
+	for i := 1; i <= maxValidators+1; i++ {
+		validators = append(validators, common.BigToAddress(big.NewInt(int64(i))))
+	}
+	governed := &Params{Period: 3, Epoch: 16, MaxValidators: 2, WiggleTime: 500 * time.Millisecond}
+
+	tests := []struct {
+		name       string
+		governance *big.Int
+		validators []common.Address
+		rejected   bool
+	}{
+		{"historical epoch above the default maximum", nil, validators, false},
+		{"governed epoch within the maximum", common.Big0, validators[:2], false},
+		{"governed epoch above the maximum", common.Big0, validators[:3], true},
+	}
+	for _, tt := range tests {
+		var (
+			config = &params.CongressConfig{Period: 3, Epoch: 16, GovernanceBlock: tt.governance}
+			engine = &Congress{config: config, recents: mustNewARC(8), fakeDiff: true}
+			parent = &types.Header{Number: big.NewInt(15), Time: 100, GasLimit: 8000000, Extra: epochExtra()}
+			header = &types.Header{Number: big.NewInt(16), ParentHash: parent.Hash(), Time: 103, GasLimit: 8000000, Extra: epochExtra(tt.validators...)}
+		)
+		if tt.governance != nil {
+			header.MixDigest = governed.encode()
+		}
+		snap := newSnapshot(config, nil, 15, parent.Hash(), validators)
+		snap.Params = governed
+		engine.recents.Add(parent.Hash(), snap)
+
+		err := engine.verifyCascadingFields(testHeaderChain{}, header, []*types.Header{parent})
+		if rejected := err == errInvalidValidatorsLength; rejected != tt.rejected {
+			t.Errorf("%s: rejection mismatch: have %v, want rejected %v", tt.name, err, tt.rejected)
+		}
+	}
+}
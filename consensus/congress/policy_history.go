@@ -0,0 +1,326 @@
+package congress
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	policyIndexSection    = 256                    // Number of blocks in a policy history section
+	policyIndexConfirms   = 16                     // Number of confirmations before a section is indexed
+	policyIndexThrottling = 100 * time.Millisecond // Time to wait between processing two consecutive sections
+)
+
+// The policy history records are kept under their own congress-policy- prefix.
+var (
+	policyLogPrefix   = []byte("congress-policy-log-")  // policyLogPrefix + num (uint64 big endian) + log index (uint32 big endian) -> policy change
+	policyAddrPrefix  = []byte("congress-policy-addr-") // policyAddrPrefix + address + num (uint64 big endian) + log index (uint32 big endian) -> nil
+	policyIndexPrefix = "congress-policy-index-"        // Prefix of the chain indexer's own records
+)
+
+// String implements fmt.Stringer.
+func (d blacklistDirection) String() string {
+	switch d {
+	case DirectionFrom:
+		return "from"
+	case DirectionTo:
+		return "to"
+	case DirectionBoth:
+		return "both"
+	default:
+		return "unknown"
+	}
+}
+
+// PolicyChange is a single change of the blacklist, the whitelists or the event
+// check rules, as emitted by the AddressList and UserAddressList contracts.
+type PolicyChange struct {
+	BlockNumber uint64         `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	TxHash      common.Hash    `json:"transactionHash"`
+	TxIndex     uint           `json:"transactionIndex"`
+	LogIndex    uint           `json:"logIndex"`
+	Contract    common.Address `json:"contract"`
+	Event       string         `json:"event"`
+	Admin       common.Address `json:"admin"` // Sender of the transaction, or proposer of a governance transaction
+
+	Address      *common.Address `json:"address,omitempty"`      // Subject of the change, if any
+	Direction    string          `json:"direction,omitempty"`    // Blacklist direction
+	EventSig     *common.Hash    `json:"eventSig,omitempty"`     // Event signature of a rule change
+	RuleLocation string          `json:"ruleLocation,omitempty"` // Address location of a rule change
+	RuleIndex    *uint64         `json:"ruleIndex,omitempty"`    // Topic or data word index of a rule change
+	CheckType    string          `json:"checkType,omitempty"`    // Check type of a rule change
+	Enabled      *bool           `json:"enabled,omitempty"`      // New state of an EnableStateChanged event
+}
+
+func policyLogKey(number uint64, logIndex uint) []byte {
+	key := make([]byte, len(policyLogPrefix)+12)
+	copy(key, policyLogPrefix)
+	binary.BigEndian.PutUint64(key[len(policyLogPrefix):], number)
+	binary.BigEndian.PutUint32(key[len(policyLogPrefix)+8:], uint32(logIndex))
+	return key
+}
+
+func policyAddrKey(addr common.Address, number uint64, logIndex uint) []byte {
+	key := append(append([]byte{}, policyAddrPrefix...), addr.Bytes()...)
+	return append(key, policyLogKey(number, logIndex)[len(policyLogPrefix):]...)
+}
+
+// ReadPolicyHistory retrieves all indexed policy changes of the given address, in
+// chain order.
+func ReadPolicyHistory(db ethdb.Database, addr common.Address) ([]*PolicyChange, error) {
+	prefix := append(append([]byte{}, policyAddrPrefix...), addr.Bytes()...)
+	it := db.NewIterator(prefix, nil)
+	defer it.Release()
+
+	changes := make([]*PolicyChange, 0)
+	for it.Next() {
+		pos := it.Key()[len(prefix):]
+		if len(pos) != 12 {
+			continue
+		}
+		blob, err := db.Get(append(append([]byte{}, policyLogPrefix...), pos...))
+		if err != nil {
+			return nil, err
+		}
+		change := new(PolicyChange)
+		if err := json.Unmarshal(blob, change); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, it.Error()
+}
+
+// IteratePolicyChanges calls fn with all indexed policy changes between the from
+// and to blocks (both inclusive) in chain order, until fn returns false.
+func IteratePolicyChanges(db ethdb.Database, from, to uint64, fn func(*PolicyChange) bool) error {
+	it := db.NewIterator(policyLogPrefix, policyLogKey(from, 0)[len(policyLogPrefix):])
+	defer it.Release()
+
+	for it.Next() {
+		if len(it.Key()) != len(policyLogPrefix)+12 {
+			continue
+		}
+		if binary.BigEndian.Uint64(it.Key()[len(policyLogPrefix):]) > to {
+			break
+		}
+		change := new(PolicyChange)
+		if err := json.Unmarshal(it.Value(), change); err != nil {
+			return err
+		}
+		if !fn(change) {
+			break
+		}
+	}
+	return it.Error()
+}
+
+// PolicyIndexer implements core.ChainIndexerBackend, indexing all changes of
+// the blacklist, the whitelists and the event check rules.
+type PolicyIndexer struct {
+	db      ethdb.Database
+	config  *params.ChainConfig
+	section uint64
+	changes []*PolicyChange
+}
+
+// NewPolicyIndexer returns a chain indexer that records every policy change with
+// its block, transaction and admin.
+func NewPolicyIndexer(db ethdb.Database, config *params.ChainConfig) *core.ChainIndexer {
+	backend := &PolicyIndexer{
+		db:     db,
+		config: config,
+	}
+	table := rawdb.NewTable(db, policyIndexPrefix)
+
+	return core.NewChainIndexer(db, table, backend, policyIndexSection, policyIndexConfirms, policyIndexThrottling, "policy")
+}
+
+// Reset implements core.ChainIndexerBackend, starting a new policy history section
+// and dropping anything indexed for it before (the section is being reprocessed
+// after a reorg).
+func (p *PolicyIndexer) Reset(ctx context.Context, section uint64, lastSectionHead common.Hash) error {
+	p.section, p.changes = section, nil
+
+	batch := p.db.NewBatch()
+	err := IteratePolicyChanges(p.db, section*policyIndexSection, (section+1)*policyIndexSection-1, func(change *PolicyChange) bool {
+		batch.Delete(policyLogKey(change.BlockNumber, change.LogIndex))
+		if change.Address != nil {
+			batch.Delete(policyAddrKey(*change.Address, change.BlockNumber, change.LogIndex))
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// Process implements core.ChainIndexerBackend, collecting the policy changes of
+// a new header.
+func (p *PolicyIndexer) Process(ctx context.Context, header *types.Header) error {
+	if !types.BloomLookup(header.Bloom, systemcontract.AddressListContractAddr) &&
+		!types.BloomLookup(header.Bloom, systemcontract.UserAddressListContractAddr) {
+		return nil
+	}
+	hash, number := header.Hash(), header.Number.Uint64()
+	body := rawdb.ReadBody(p.db, hash, number)
+	receipts := rawdb.ReadReceipts(p.db, hash, number, p.config)
+	if body == nil || receipts == nil {
+		return fmt.Errorf("missing body or receipts of block #%d [%x]", number, hash)
+	}
+	changes, err := parsePolicyChanges(p.config, header, body.Transactions, receipts)
+	if err != nil {
+		return err
+	}
+	p.changes = append(p.changes, changes...)
+	return nil
+}
+
+// Commit implements core.ChainIndexerBackend, writing the collected policy
+// changes into the database.
+func (p *PolicyIndexer) Commit() error {
+	batch := p.db.NewBatch()
+	for _, change := range p.changes {
+		blob, err := json.Marshal(change)
+		if err != nil {
+			return err
+		}
+		if err := batch.Put(policyLogKey(change.BlockNumber, change.LogIndex), blob); err != nil {
+			return err
+		}
+		if change.Address != nil {
+			if err := batch.Put(policyAddrKey(*change.Address, change.BlockNumber, change.LogIndex), []byte{}); err != nil {
+				return err
+			}
+		}
+	}
+	if len(p.changes) > 0 {
+		log.Debug("Indexed policy changes", "section", p.section, "changes", len(p.changes))
+	}
+	return batch.Write()
+}
+
+// Prune returns an empty error since the policy history is an audit trail and
+// is never pruned.
+func (p *PolicyIndexer) Prune(threshold uint64) error {
+	return nil
+}
+
+// parsePolicyChanges extracts the policy changes from the receipts of a block.
+func parsePolicyChanges(config *params.ChainConfig, header *types.Header, txs types.Transactions, receipts types.Receipts) ([]*PolicyChange, error) {
+	if len(txs) != len(receipts) {
+		return nil, errors.New("transaction and receipt count mismatch")
+	}
+	var (
+		signer  = types.MakeSigner(config, header.Number)
+		abis    = systemcontract.GetInteractiveABI()
+		changes []*PolicyChange
+	)
+	for i, receipt := range receipts {
+		var admin *common.Address
+		for _, l := range receipt.Logs {
+			var name string
+			switch l.Address {
+			case systemcontract.AddressListContractAddr:
+				name = systemcontract.AddressListContractName
+			case systemcontract.UserAddressListContractAddr:
+				name = systemcontract.UserAddressListContractName
+			default:
+				continue
+			}
+			if len(l.Topics) == 0 {
+				continue
+			}
+			contractABI := abis[name]
+			event, err := contractABI.EventByID(l.Topics[0])
+			if err != nil {
+				continue
+			}
+			if admin == nil {
+				sender, err := policyAdmin(signer, txs[i], header)
+				if err != nil {
+					return nil, err
+				}
+				admin = &sender
+			}
+			change := &PolicyChange{
+				BlockNumber: header.Number.Uint64(),
+				BlockHash:   header.Hash(),
+				TxHash:      l.TxHash,
+				TxIndex:     uint(i),
+				LogIndex:    l.Index,
+				Contract:    l.Address,
+				Event:       event.Name,
+				Admin:       *admin,
+			}
+			// Events with indexed arguments only have no data to unpack
+			args := make(map[string]interface{})
+			if err := event.Inputs.NonIndexed().UnpackIntoMap(args, l.Data); err != nil {
+				log.Warn("Failed to unpack policy event", "event", event.Name, "tx", l.TxHash, "err", err)
+				continue
+			}
+			switch event.Name {
+			case "BlackAddrAdded", "BlackAddrRemoved", "DeveloperAdded", "DeveloperRemoved", "UserAdded", "UserRemoved", "AdminChanged", "AdminChanging":
+				if len(l.Topics) > 1 {
+					addr := common.BytesToAddress(l.Topics[1].Bytes())
+					change.Address = &addr
+				}
+				if d, ok := args["d"].(uint8); ok {
+					change.Direction = blacklistDirection(d).String()
+				}
+			case "RuleAdded", "RuleRemoved", "RuleUpdated":
+				if len(l.Topics) > 1 {
+					sig := l.Topics[1]
+					change.EventSig = &sig
+				}
+				if checkIdx, ok := args["checkIdx"].(*big.Int); ok {
+					location, idx := systemcontract.UnpackRuleCheckIdx(checkIdx)
+					change.RuleLocation, change.RuleIndex = location.String(), &idx
+				}
+				if t, ok := args["t"].(uint8); ok {
+					change.CheckType = common.AddressCheckType(t).String()
+				}
+			case "EnableStateChanged":
+				if len(l.Topics) > 1 {
+					enabled := l.Topics[1] != (common.Hash{})
+					change.Enabled = &enabled
+				}
+			}
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+// policyAdmin returns the account behind a policy change, which is the proposer
+// for system governance transactions, and the sender for any other one.
+func policyAdmin(signer types.Signer, tx *types.Transaction, header *types.Header) (common.Address, error) {
+	sender, err := types.Sender(signer, tx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if to := tx.To(); to != nil && *to == systemcontract.SysGovToAddr && sender == header.Coinbase {
+		prop := new(Proposal)
+		if err := rlp.DecodeBytes(tx.Data(), prop); err == nil {
+			return prop.From, nil
+		}
+	}
+	return sender, nil
+}
@@ -0,0 +1,196 @@
+package congress
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func TestPolicyHistory(t *testing.T) {
+	var (
+		db      = rawdb.NewMemoryDatabase()
+		black   = common.HexToAddress("0x01")
+		other   = common.HexToAddress("0x02")
+		indexer = &PolicyIndexer{db: db}
+	)
+	indexer.changes = []*PolicyChange{
+		{BlockNumber: 10, LogIndex: 0, Event: "BlackAddrAdded", Address: &black, Direction: "to"},
+		{BlockNumber: 10, LogIndex: 1, Event: "DeveloperAdded", Address: &other},
+		{BlockNumber: 300, LogIndex: 0, Event: "BlackAddrRemoved", Address: &black},
+	}
+	if err := indexer.Commit(); err != nil {
+		t.Fatalf("failed to commit policy changes: %v", err)
+	}
+	history, err := ReadPolicyHistory(db, black)
+	if err != nil {
+		t.Fatalf("failed to read policy history: %v", err)
+	}
+	if len(history) != 2 || history[0].Event != "BlackAddrAdded" || history[1].Event != "BlackAddrRemoved" {
+		t.Fatalf("policy history mismatch: %+v", history)
+	}
+	var events []string
+	if err := IteratePolicyChanges(db, 0, 255, func(change *PolicyChange) bool {
+		events = append(events, change.Event)
+		return true
+	}); err != nil {
+		t.Fatalf("failed to iterate policy changes: %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("policy changes of range mismatch: have %v", events)
+	}
+	// Reprocessing the first section drops its changes only
+	if err := indexer.Reset(context.Background(), 0, common.Hash{}); err != nil {
+		t.Fatalf("failed to reset section: %v", err)
+	}
+	if history, _ = ReadPolicyHistory(db, black); len(history) != 1 || history[0].BlockNumber != 300 {
+		t.Fatalf("policy history after reset mismatch: %+v", history)
+	}
+	if history, _ = ReadPolicyHistory(db, other); len(history) != 0 {
+		t.Fatalf("policy history of reset section not dropped: %+v", history)
+	}
+}
+
+func TestParsePolicyChanges(t *testing.T) {
+	var (
+		config      = params.TestChainConfig
+		adminKey, _ = crypto.GenerateKey()
+		minerKey, _ = crypto.GenerateKey()
+		admin       = crypto.PubkeyToAddress(adminKey.PublicKey)
+		miner       = crypto.PubkeyToAddress(minerKey.PublicKey)
+		proposer    = common.HexToAddress("0x9209")
+		subject     = common.HexToAddress("0x5b")
+		eventSig    = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+		header      = &types.Header{Number: big.NewInt(10), Coinbase: miner}
+		signer      = types.MakeSigner(config, header.Number)
+		abis        = systemcontract.GetInteractiveABI()
+	)
+	// newLog builds the log of the given event of a policy contract
+	newLog := func(contract common.Address, name, event string, topic common.Hash, args ...interface{}) *types.Log {
+		ev := abis[name].Events[event]
+		data, err := ev.Inputs.NonIndexed().Pack(args...)
+		if err != nil {
+			t.Fatalf("failed to pack %s: %v", event, err)
+		}
+		return &types.Log{Address: contract, Topics: []common.Hash{ev.ID, topic}, Data: data}
+	}
+	addressList := func(event string, topic common.Hash, args ...interface{}) *types.Log {
+		return newLog(systemcontract.AddressListContractAddr, systemcontract.AddressListContractName, event, topic, args...)
+	}
+	userAddressList := func(event string, topic common.Hash, args ...interface{}) *types.Log {
+		return newLog(systemcontract.UserAddressListContractAddr, systemcontract.UserAddressListContractName, event, topic, args...)
+	}
+	signTx := func(key []byte, to common.Address, data []byte) *types.Transaction {
+		priv, _ := crypto.ToECDSA(key)
+		tx, err := types.SignTx(types.NewTransaction(0, to, new(big.Int), 100000, big.NewInt(1), data), signer, priv)
+		if err != nil {
+			t.Fatalf("failed to sign transaction: %v", err)
+		}
+		return tx
+	}
+	proposal, _ := rlp.EncodeToBytes(&Proposal{Id: big.NewInt(1), Action: big.NewInt(0), From: proposer, To: systemcontract.AddressListContractAddr, Value: new(big.Int), Data: []byte{}})
+	var (
+		adminTx    = signTx(crypto.FromECDSA(adminKey), systemcontract.AddressListContractAddr, nil)
+		govTx      = signTx(crypto.FromECDSA(minerKey), systemcontract.SysGovToAddr, proposal)
+		forgedGov  = signTx(crypto.FromECDSA(adminKey), systemcontract.SysGovToAddr, proposal)
+		enabled    = true
+		ruleIndex  = uint64(2)
+		subjectRef = &subject
+	)
+	tests := []struct {
+		name string
+		tx   *types.Transaction
+		log  *types.Log
+		want *PolicyChange // Nil if the log is no policy change
+	}{
+		{
+			name: "blacklist addition",
+			tx:   adminTx,
+			log:  addressList("BlackAddrAdded", subject.Hash(), uint8(DirectionTo)),
+			want: &PolicyChange{Contract: systemcontract.AddressListContractAddr, Event: "BlackAddrAdded", Admin: admin, Address: subjectRef, Direction: "to"},
+		},
+		{
+			name: "developer removal",
+			tx:   adminTx,
+			log:  addressList("DeveloperRemoved", subject.Hash()),
+			want: &PolicyChange{Contract: systemcontract.AddressListContractAddr, Event: "DeveloperRemoved", Admin: admin, Address: subjectRef},
+		},
+		{
+			name: "user whitelist addition",
+			tx:   adminTx,
+			log:  userAddressList("UserAdded", subject.Hash()),
+			want: &PolicyChange{Contract: systemcontract.UserAddressListContractAddr, Event: "UserAdded", Admin: admin, Address: subjectRef},
+		},
+		{
+			name: "rule update",
+			tx:   adminTx,
+			log:  addressList("RuleUpdated", eventSig, systemcontract.PackRuleCheckIdx(common.LocationTopic, 2), uint8(common.CheckTo)),
+			want: &PolicyChange{Contract: systemcontract.AddressListContractAddr, Event: "RuleUpdated", Admin: admin, EventSig: &eventSig, RuleLocation: common.LocationTopic.String(), RuleIndex: &ruleIndex, CheckType: common.CheckTo.String()},
+		},
+		{
+			name: "enable state change",
+			tx:   adminTx,
+			log:  addressList("EnableStateChanged", common.BigToHash(common.Big1)),
+			want: &PolicyChange{Contract: systemcontract.AddressListContractAddr, Event: "EnableStateChanged", Admin: admin, Enabled: &enabled},
+		},
+		{
+			name: "governance change by the proposer",
+			tx:   govTx,
+			log:  addressList("BlackAddrRemoved", subject.Hash(), uint8(DirectionBoth)),
+			want: &PolicyChange{Contract: systemcontract.AddressListContractAddr, Event: "BlackAddrRemoved", Admin: proposer, Address: subjectRef, Direction: "both"},
+		},
+		{
+			name: "governance transaction not sent by the miner",
+			tx:   forgedGov,
+			log:  addressList("BlackAddrRemoved", subject.Hash(), uint8(DirectionFrom)),
+			want: &PolicyChange{Contract: systemcontract.AddressListContractAddr, Event: "BlackAddrRemoved", Admin: admin, Address: subjectRef, Direction: "from"},
+		},
+		{
+			name: "other contract",
+			tx:   adminTx,
+			log:  &types.Log{Address: common.HexToAddress("0xc0"), Topics: addressList("DeveloperAdded", subject.Hash()).Topics},
+		},
+		{
+			name: "unknown event",
+			tx:   adminTx,
+			log:  &types.Log{Address: systemcontract.AddressListContractAddr, Topics: []common.Hash{eventSig}},
+		},
+		{
+			name: "undecodable data",
+			tx:   adminTx,
+			log:  &types.Log{Address: systemcontract.AddressListContractAddr, Topics: addressList("BlackAddrAdded", subject.Hash(), uint8(DirectionTo)).Topics, Data: []byte{0x01}},
+		},
+	}
+	for _, tt := range tests {
+		tt.log.TxHash, tt.log.Index = tt.tx.Hash(), 3
+		receipts := types.Receipts{{Logs: []*types.Log{tt.log}}}
+
+		changes, err := parsePolicyChanges(config, header, types.Transactions{tt.tx}, receipts)
+		if err != nil {
+			t.Fatalf("%s: failed to parse policy changes: %v", tt.name, err)
+		}
+		if tt.want == nil {
+			if len(changes) != 0 {
+				t.Errorf("%s: unexpected policy changes: %+v", tt.name, changes)
+			}
+			continue
+		}
+		want := *tt.want
+		want.BlockNumber, want.BlockHash, want.TxHash, want.LogIndex = 10, header.Hash(), tt.tx.Hash(), 3
+		if len(changes) != 1 || !reflect.DeepEqual(changes[0], &want) {
+			t.Errorf("%s: policy changes mismatch:\nhave %+v\nwant %+v", tt.name, changes, &want)
+		}
+	}
+	// The receipts must match the transactions
+	if _, err := parsePolicyChanges(config, header, types.Transactions{adminTx}, nil); err == nil {
+		t.Errorf("transaction and receipt count mismatch not detected")
+	}
+}
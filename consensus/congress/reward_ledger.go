@@ -0,0 +1,378 @@
+package congress
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/consensus/congress/vmcaller"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	inmemoryRewards = 128 // Number of recent reward records of unsealed or unwritten blocks to keep in memory
+	rewardChanSize  = 10  // Size of the channel listening to chain events
+)
+
+// rewardPrefix + validator + num (uint64 big endian) + hash -> reward record
+var rewardPrefix = []byte("congress-reward-")
+
+// RewardRecord is the distribution of the fees of a block, as done by the
+// distributeBlockReward call of the Validators contract at the end of the block.
+// The shares are measured from the state changes of the call: the foundation
+// share is the growth of the foundation reward, the validator share the growth
+// of the pending reward of its vote pool, and the stakers share the income of
+// its vote pool. The rest of the fee is burnt by the contract according to its
+// burn rate, so the shares always add up to the fee.
+type RewardRecord struct {
+	BlockNumber uint64         `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	Validator   common.Address `json:"validator"`
+	VotePool    common.Address `json:"votePool"`
+	Fee         *hexutil.Big   `json:"fee"`
+	Foundation  *hexutil.Big   `json:"foundation"`
+	Reward      *hexutil.Big   `json:"reward"`
+	Stakers     *hexutil.Big   `json:"stakers"`
+	Burn        *hexutil.Big   `json:"burn"`
+}
+
+// rewardBalances is the part of the state changed by the reward distribution.
+type rewardBalances struct {
+	foundation *big.Int // Accumulated reward of the foundation
+	reward     *big.Int // Pending reward of the validator
+	stakers    *big.Int // Balance of the validator's vote pool
+	burnRate   *big.Int // Share of the fees burnt by the contract
+}
+
+// rewardSlots locates the balances credited by the distribution of the fees of
+// a validator, so they are read from the state without calling the contract.
+type rewardSlots struct {
+	contract   common.Address // Validators contract
+	votePool   common.Address // Vote pool of the validator, receiving the stakers share
+	foundation common.Hash    // Storage slot of the accumulated reward of the foundation
+	reward     common.Hash    // Storage slot of the pending reward of the vote pool
+	burnRate   common.Hash    // Storage slot of the burn rate
+}
+
+// read reads the balances credited by the distribution.
+func (s *rewardSlots) read(statedb *state.StateDB) *rewardBalances {
+	return &rewardBalances{
+		foundation: statedb.GetState(s.contract, s.foundation).Big(),
+		reward:     statedb.GetState(s.contract, s.reward).Big(),
+		stakers:    statedb.GetBalance(s.votePool),
+		burnRate:   statedb.GetState(s.contract, s.burnRate).Big(),
+	}
+}
+
+func rewardKey(validator common.Address, number uint64, hash common.Hash) []byte {
+	key := make([]byte, len(rewardPrefix)+common.AddressLength+8+common.HashLength)
+	copy(key, rewardPrefix)
+	copy(key[len(rewardPrefix):], validator.Bytes())
+	binary.BigEndian.PutUint64(key[len(rewardPrefix)+common.AddressLength:], number)
+	copy(key[len(rewardPrefix)+common.AddressLength+8:], hash.Bytes())
+	return key
+}
+
+// WriteRewardRecord stores the reward record of a block.
+func WriteRewardRecord(db ethdb.KeyValueWriter, record *RewardRecord) error {
+	blob, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return db.Put(rewardKey(record.Validator, record.BlockNumber, record.BlockHash), blob)
+}
+
+// ReadRewardRecord retrieves the reward record of a block sealed by the given
+// validator, or nil if the block isn't recorded.
+func ReadRewardRecord(db ethdb.KeyValueReader, validator common.Address, number uint64, hash common.Hash) (*RewardRecord, error) {
+	blob, err := db.Get(rewardKey(validator, number, hash))
+	if err != nil || len(blob) == 0 {
+		return nil, nil
+	}
+	record := new(RewardRecord)
+	if err := json.Unmarshal(blob, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// ReadRewards retrieves the reward records of a validator for the canonical blocks
+// between from and to (both inclusive), in chain order.
+func ReadRewards(db ethdb.Database, validator common.Address, from, to uint64) ([]*RewardRecord, error) {
+	prefix := append(append([]byte{}, rewardPrefix...), validator.Bytes()...)
+	start := make([]byte, 8)
+	binary.BigEndian.PutUint64(start, from)
+
+	it := db.NewIterator(prefix, start)
+	defer it.Release()
+
+	records := make([]*RewardRecord, 0)
+	for it.Next() {
+		pos := it.Key()[len(prefix):]
+		if len(pos) != 8+common.HashLength {
+			continue
+		}
+		number := binary.BigEndian.Uint64(pos)
+		if number > to {
+			break
+		}
+		// Skip the records of blocks reorged out of the chain
+		if rawdb.ReadCanonicalHash(db, number) != common.BytesToHash(pos[8:]) {
+			continue
+		}
+		record := new(RewardRecord)
+		if err := json.Unmarshal(it.Value(), record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, it.Error()
+}
+
+// rewardChain is the part of the blockchain the reward ledger listens to.
+type rewardChain interface {
+	SubscribeChainEvent(ch chan<- core.ChainEvent) event.Subscription
+}
+
+// rewardLedger records the fee distribution of every block. The distribution
+// is measured when the block is finalized, and is written with the block hash
+// once the block is inserted into the chain. Blocks which become canonical by a
+// reorg without being inserted as the head are not recorded.
+type rewardLedger struct {
+	db      ethdb.Database
+	pending *lru.Cache // State root -> distribution of finalized but not yet inserted blocks
+	slots   sync.Map   // Validator -> reward slots, dropped at every epoch block
+
+	feed  event.Feed
+	scope event.SubscriptionScope
+
+	sub  event.Subscription
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newRewardLedger(db ethdb.Database, chain rewardChain) *rewardLedger {
+	pending, _ := lru.New(inmemoryRewards)
+	ledger := &rewardLedger{
+		db:      db,
+		pending: pending,
+		quit:    make(chan struct{}),
+	}
+	events := make(chan core.ChainEvent, rewardChanSize)
+	ledger.sub = chain.SubscribeChainEvent(events)
+
+	ledger.wg.Add(1)
+	go ledger.loop(events)
+	return ledger
+}
+
+// loop writes the reward records of the blocks inserted into the chain.
+func (l *rewardLedger) loop(events chan core.ChainEvent) {
+	defer l.wg.Done()
+
+	for {
+		select {
+		case ev := <-events:
+			if ev.Block == nil {
+				continue
+			}
+			root := ev.Block.Root()
+			v, ok := l.pending.Get(root)
+			if !ok {
+				continue // no fee in the block, or finalized before the ledger started
+			}
+			l.pending.Remove(root)
+
+			record := *v.(*RewardRecord)
+			record.BlockHash = ev.Hash
+			if err := WriteRewardRecord(l.db, &record); err != nil {
+				log.Error("Failed to write reward record", "number", record.BlockNumber, "hash", record.BlockHash, "err", err)
+				continue
+			}
+			l.feed.Send(&record)
+
+		case <-l.sub.Err():
+			return
+		case <-l.quit:
+			return
+		}
+	}
+}
+
+// subscribe registers a subscription for the reward records of the inserted blocks.
+func (l *rewardLedger) subscribe(ch chan<- *RewardRecord) event.Subscription {
+	return l.scope.Track(l.feed.Subscribe(ch))
+}
+
+func (l *rewardLedger) close() {
+	l.sub.Unsubscribe()
+	close(l.quit)
+	l.wg.Wait()
+	l.scope.Close()
+}
+
+// StartRewardLedger starts recording the fee distribution of the blocks of the
+// given chain.
+func (c *Congress) StartRewardLedger(chain rewardChain) {
+	c.rewards = newRewardLedger(c.db, chain)
+}
+
+// SubscribeRewards registers a subscription for the reward records of the blocks
+// inserted into the chain. It returns nil if the reward ledger isn't running.
+func (c *Congress) SubscribeRewards(ch chan<- *RewardRecord) event.Subscription {
+	if c.rewards == nil {
+		return nil
+	}
+	return c.rewards.subscribe(ch)
+}
+
+// rewardSlots returns the location of the balances credited by the distribution
+// of the fees of the header's validator. It's found once per validator and epoch
+// by tracing the storage reads of the foundationReward, pendingReward and burnRate
+// views on a copy of the state, so the distribution of every block is measured by plain
+// storage reads.
+func (c *Congress) rewardSlots(header *types.Header, statedb *state.StateDB) (*rewardSlots, error) {
+	if slots, ok := c.rewards.slots.Load(header.Coinbase); ok {
+		return slots.(*rewardSlots), nil
+	}
+	var (
+		contract = *systemcontract.GetValidatorAddr(header.Number, c.chainConfig)
+		cpy      = statedb.Copy()
+	)
+	caller, err := c.validatorsContract(header, cpy, newMinimalChainContext(c))
+	if err != nil {
+		return nil, err
+	}
+	votePool, err := caller.VotePools(nil, header.Coinbase)
+	if err != nil {
+		return nil, err
+	}
+	if votePool == (common.Address{}) {
+		return nil, fmt.Errorf("no vote pool for validator %s", header.Coinbase.Hex())
+	}
+	foundation, err := c.viewSlot(header, cpy, contract, "foundationReward")
+	if err != nil {
+		return nil, err
+	}
+	reward, err := c.viewSlot(header, cpy, contract, "pendingReward", votePool)
+	if err != nil {
+		return nil, err
+	}
+	burnRate, err := c.viewSlot(header, cpy, contract, "burnRate")
+	if err != nil {
+		return nil, err
+	}
+	slots := &rewardSlots{contract: contract, votePool: votePool, foundation: foundation, reward: reward, burnRate: burnRate}
+	c.rewards.slots.Store(header.Coinbase, slots)
+	return slots, nil
+}
+
+// dropRewardSlots forgets the reward slots of all validators, as their vote pools
+// may change with the validator set.
+func (c *Congress) dropRewardSlots() {
+	if c.rewards == nil {
+		return
+	}
+	c.rewards.slots.Range(func(key, _ interface{}) bool {
+		c.rewards.slots.Delete(key)
+		return true
+	})
+}
+
+// viewSlot returns the storage slot of the Validators contract read by the given
+// view, which must read a single one.
+func (c *Congress) viewSlot(header *types.Header, statedb *state.StateDB, contract common.Address, method string, args ...interface{}) (common.Hash, error) {
+	data, err := c.abi[systemcontract.ValidatorsContractName].Pack(method, args...)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	recorder := &slotRecorder{contract: contract}
+	msg := vmcaller.NewLegacyMessage(header.Coinbase, &contract, 0, new(big.Int), math.MaxUint64, new(big.Int), data, false)
+	if _, err := vmcaller.ExecuteTracedMsg(method, recorder, msg, statedb, header, newMinimalChainContext(c), c.chainConfig); err != nil {
+		return common.Hash{}, err
+	}
+	if len(recorder.slots) != 1 {
+		return common.Hash{}, fmt.Errorf("%s reads %d storage slots", method, len(recorder.slots))
+	}
+	return recorder.slots[0], nil
+}
+
+// slotRecorder is a system call tracer recording the storage slots of a contract
+// read by the call.
+type slotRecorder struct {
+	contract common.Address
+	slots    []common.Hash
+}
+
+func (r *slotRecorder) StartSysCall(method string) vm.EVMLogger         { return r }
+func (r *slotRecorder) EndSysCall(method string, ret []byte, err error) {}
+func (r *slotRecorder) CaptureEnter(vm.OpCode, common.Address, common.Address, []byte, uint64, *big.Int) {
+}
+func (r *slotRecorder) CaptureExit([]byte, uint64, error) {}
+func (r *slotRecorder) CaptureStart(*vm.EVM, common.Address, common.Address, bool, []byte, uint64, *big.Int) {
+}
+func (r *slotRecorder) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {
+}
+func (r *slotRecorder) CaptureEnd([]byte, uint64, time.Duration, error) {}
+
+func (r *slotRecorder) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if op == vm.SLOAD && scope.Contract.Address() == r.contract {
+		r.slots = append(r.slots, common.Hash(scope.Stack.Back(0).Bytes32()))
+	}
+}
+
+// newRewardRecord creates the reward record of a block from the balances before
+// and after the distribution of its fee. The part of the fee not credited to any
+// balance is the burnt share. Without a burn rate in effect before the
+// distribution, that part is kept undistributed by the contract, which is only
+// logged, so the shares still add up to the fee.
+func newRewardRecord(header *types.Header, fee *big.Int, votePool common.Address, before, after *rewardBalances) (*RewardRecord, error) {
+	var (
+		foundation = new(big.Int).Sub(after.foundation, before.foundation)
+		reward     = new(big.Int).Sub(after.reward, before.reward)
+		stakers    = new(big.Int).Sub(after.stakers, before.stakers)
+		burn       = new(big.Int).Sub(fee, foundation)
+	)
+	burn.Sub(burn, reward)
+	burn.Sub(burn, stakers)
+	if burn.Sign() < 0 {
+		return nil, fmt.Errorf("fee split mismatch: fee %v, foundation %v, reward %v, stakers %v", fee, foundation, reward, stakers)
+	}
+	if burn.Sign() > 0 && before.burnRate.Sign() == 0 {
+		log.Debug("Block fee kept undistributed", "number", header.Number, "validator", header.Coinbase, "amount", burn)
+	}
+	return &RewardRecord{
+		BlockNumber: header.Number.Uint64(),
+		Validator:   header.Coinbase,
+		VotePool:    votePool,
+		Fee:         (*hexutil.Big)(new(big.Int).Set(fee)),
+		Foundation:  (*hexutil.Big)(foundation),
+		Reward:      (*hexutil.Big)(reward),
+		Stakers:     (*hexutil.Big)(stakers),
+		Burn:        (*hexutil.Big)(burn),
+	}, nil
+}
+
+// keepReward keeps the reward record of a finalized block until the block is
+// inserted into the chain. The record is keyed by the state root, as the hash
+// of a mined block isn't known before it's sealed.
+func (c *Congress) keepReward(header *types.Header, record *RewardRecord) {
+	if c.rewards != nil && record != nil {
+		c.rewards.pending.Add(header.Root, record)
+	}
+}
@@ -0,0 +1,126 @@
+package congress
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestRewardRecord(t *testing.T) {
+	var (
+		header = &types.Header{Number: big.NewInt(10), Coinbase: common.HexToAddress("0x01")}
+		after  = &rewardBalances{foundation: big.NewInt(110), reward: big.NewInt(30), stakers: big.NewInt(1050)}
+	)
+	for _, tt := range []struct {
+		fee      int64
+		burnRate int64
+		burn     int64
+		fail     bool
+	}{
+		{fee: 90, burnRate: 0, burn: 0},
+		{fee: 100, burnRate: 10, burn: 10},
+		{fee: 100, burnRate: 0, burn: 10},   // kept undistributed by the contract
+		{fee: 80, burnRate: 10, fail: true}, // more credited than the fee
+	} {
+		before := &rewardBalances{foundation: big.NewInt(100), reward: big.NewInt(0), stakers: big.NewInt(1000), burnRate: big.NewInt(tt.burnRate)}
+		record, err := newRewardRecord(header, big.NewInt(tt.fee), common.HexToAddress("0x02"), before, after)
+		if tt.fail {
+			if err == nil {
+				t.Fatalf("fee %d, burn rate %d: mismatching split recorded: %+v", tt.fee, tt.burnRate, record)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("fee %d, burn rate %d: failed to record: %v", tt.fee, tt.burnRate, err)
+		}
+		if record.Foundation.ToInt().Int64() != 10 || record.Reward.ToInt().Int64() != 30 || record.Stakers.ToInt().Int64() != 50 || record.Burn.ToInt().Int64() != tt.burn {
+			t.Fatalf("fee %d, burn rate %d: reward split mismatch: %+v", tt.fee, tt.burnRate, record)
+		}
+	}
+}
+
+func TestRewardSlots(t *testing.T) {
+	var (
+		chainConfig = *params.TestChainConfig
+		engine      = &Congress{config: &params.CongressConfig{Period: 3, Epoch: 200}, abi: systemcontract.GetInteractiveABI()}
+		header      = &types.Header{Number: big.NewInt(1), Difficulty: diffInTurn, GasLimit: 8000000}
+		contract    = systemcontract.ValidatorsContractAddr
+	)
+	chainConfig.Congress = engine.config
+	engine.chainConfig = &chainConfig
+
+	for _, tt := range []struct {
+		code []byte
+		slot common.Hash
+		fail bool
+	}{
+		// sload(5) and return it
+		{code: common.FromHex("0x60055460005260206000f3"), slot: common.BigToHash(big.NewInt(5))},
+		// sload(5) + sload(6), the balance can't be located
+		{code: common.FromHex("0x6005546006540160005260206000f3"), fail: true},
+	} {
+		statedb := mustNewState(t)
+		statedb.SetCode(contract, tt.code)
+
+		slot, err := engine.viewSlot(header, statedb, contract, "pendingReward", common.HexToAddress("0x02"))
+		if tt.fail {
+			if err == nil {
+				t.Fatalf("ambiguous view located at %x", slot)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("failed to locate view slot: %v", err)
+		}
+		if slot != tt.slot {
+			t.Fatalf("view slot mismatch: have %x, want %x", slot, tt.slot)
+		}
+	}
+
+	var (
+		statedb = mustNewState(t)
+		slots   = &rewardSlots{contract: contract, votePool: common.HexToAddress("0x02"), foundation: common.BigToHash(big.NewInt(1)), reward: common.BigToHash(big.NewInt(2)), burnRate: common.BigToHash(big.NewInt(3))}
+	)
+	statedb.SetState(contract, slots.foundation, common.BigToHash(big.NewInt(10)))
+	statedb.SetState(contract, slots.reward, common.BigToHash(big.NewInt(20)))
+	statedb.SetState(contract, slots.burnRate, common.BigToHash(big.NewInt(40)))
+	statedb.AddBalance(slots.votePool, big.NewInt(30))
+	if b := slots.read(statedb); b.foundation.Int64() != 10 || b.reward.Int64() != 20 || b.stakers.Int64() != 30 || b.burnRate.Int64() != 40 {
+		t.Fatalf("reward balances mismatch: %+v", b)
+	}
+}
+
+func TestReadRewards(t *testing.T) {
+	var (
+		db        = rawdb.NewMemoryDatabase()
+		validator = common.HexToAddress("0x01")
+		other     = common.HexToAddress("0x02")
+	)
+	write := func(validator common.Address, number uint64, hash common.Hash, canonical bool) {
+		if canonical {
+			rawdb.WriteCanonicalHash(db, hash, number)
+		}
+		record := &RewardRecord{BlockNumber: number, BlockHash: hash, Validator: validator}
+		if err := WriteRewardRecord(db, record); err != nil {
+			t.Fatalf("failed to write reward record: %v", err)
+		}
+	}
+	write(validator, 1, common.HexToHash("0x01"), true)
+	write(validator, 2, common.HexToHash("0x02"), true)
+	write(validator, 2, common.HexToHash("0x12"), false) // reorged out
+	write(other, 3, common.HexToHash("0x03"), true)
+	write(validator, 4, common.HexToHash("0x04"), true)
+
+	records, err := ReadRewards(db, validator, 2, 4)
+	if err != nil {
+		t.Fatalf("failed to read rewards: %v", err)
+	}
+	if len(records) != 2 || records[0].BlockHash != common.HexToHash("0x02") || records[1].BlockNumber != 4 {
+		t.Fatalf("reward records mismatch: %+v", records)
+	}
+}
@@ -19,6 +19,7 @@ package congress
 import (
 	"bytes"
 	"encoding/json"
+	"math/big"
 	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -34,10 +35,11 @@ type Snapshot struct {
 	config   *params.CongressConfig // Consensus engine parameters to fine tune behavior
 	sigcache *lru.ARCCache          // Cache of recent block signatures to speed up ecrecover
 
-	Number     uint64                      `json:"number"`     // Block number where the snapshot was created
-	Hash       common.Hash                 `json:"hash"`       // Block hash where the snapshot was created
-	Validators map[common.Address]struct{} `json:"validators"` // Set of authorized validators at this moment
-	Recents    map[uint64]common.Address   `json:"recents"`    // Set of recent validators for spam protections
+	Number     uint64                      `json:"number"`           // Block number where the snapshot was created
+	Hash       common.Hash                 `json:"hash"`             // Block hash where the snapshot was created
+	Validators map[common.Address]struct{} `json:"validators"`       // Set of authorized validators at this moment
+	Recents    map[uint64]common.Address   `json:"recents"`          // Set of recent validators for spam protections
+	Params     *Params                     `json:"params,omitempty"` // Governed consensus parameters in effect, nil for the defaults
 }
 
 // validatorsAscending implements the sort interface to allow sorting a list of addresses
@@ -67,7 +69,7 @@ func newSnapshot(config *params.CongressConfig, sigcache *lru.ARCCache, number u
 
 // loadSnapshot loads an existing snapshot from the database.
 func loadSnapshot(config *params.CongressConfig, sigcache *lru.ARCCache, db ethdb.Database, hash common.Hash) (*Snapshot, error) {
-	blob, err := db.Get(append([]byte("congress-"), hash[:]...))
+	blob, err := db.Get(append(append([]byte{}, snapshotPrefix...), hash[:]...))
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +89,7 @@ func (s *Snapshot) store(db ethdb.Database) error {
 	if err != nil {
 		return err
 	}
-	return db.Put(append([]byte("congress-"), s.Hash[:]...), blob)
+	return db.Put(append(append([]byte{}, snapshotPrefix...), s.Hash[:]...), blob)
 }
 
 // copy creates a deep copy of the snapshot, though not the individual votes.
@@ -99,6 +101,7 @@ func (s *Snapshot) copy() *Snapshot {
 		Hash:       s.Hash,
 		Validators: make(map[common.Address]struct{}),
 		Recents:    make(map[uint64]common.Address),
+		Params:     s.Params,
 	}
 	for validator := range s.Validators {
 		cpy.Validators[validator] = struct{}{}
@@ -150,9 +153,8 @@ func (s *Snapshot) apply(headers []*types.Header, chain consensus.ChainHeaderRea
 			}
 		}
 		snap.Recents[number] = validator
-
-		// update validators at the first block at epoch
-		if number > 0 && number%s.config.Epoch == 0 {
+		// update validators and consensus parameters at the first block at epoch
+		if number > 0 && number%snap.params().Epoch == 0 {
 			checkpointHeader := header
 
 			// NOTE(yqq): parse all validators from header.Extra, 2022-08-11
@@ -173,8 +175,15 @@ func (s *Snapshot) apply(headers []*types.Header, chain consensus.ChainHeaderRea
 			for i := 0; i < len(snap.Validators)/2-len(newValidators)/2; i++ {
 				delete(snap.Recents, number-limit-uint64(i))
 			}
-
 			snap.Validators = newValidators
+
+			if s.config.IsGovernance(header.Number) {
+				params, err := decodeParams(header.MixDigest)
+				if err != nil {
+					return nil, err
+				}
+				snap.Params = params
+			}
 		}
 	}
 
@@ -184,6 +193,14 @@ func (s *Snapshot) apply(headers []*types.Header, chain consensus.ChainHeaderRea
 	return snap, nil
 }
 
+// params returns the consensus parameters in effect for the block after the snapshot.
+func (s *Snapshot) params() *Params {
+	if s.Params != nil && s.config.IsGovernance(new(big.Int).SetUint64(s.Number+1)) {
+		return s.Params
+	}
+	return defaultParams(s.config)
+}
+
 // validators retrieves the list of authorized validators in ascending order.
 func (s *Snapshot) validators() []common.Address {
 	sigs := make([]common.Address, 0, len(s.Validators))
@@ -194,6 +211,23 @@ func (s *Snapshot) validators() []common.Address {
 	return sigs
 }
 
+// missedValidator returns the in-turn validator of the given block, the one after
+// the snapshot, if the block is sealed out-of-turn. The validator is punished for
+// missing its turn unless it signed recently, in which case it returns false.
+func (s *Snapshot) missedValidator(number uint64) (common.Address, bool) {
+	validators := s.validators()
+	if len(validators) == 0 {
+		return common.Address{}, false
+	}
+	missed := validators[number%uint64(len(validators))]
+	for _, recent := range s.Recents {
+		if recent == missed {
+			return common.Address{}, false
+		}
+	}
+	return missed, true
+}
+
 // inturn returns if a validator at a given block height is in-turn or not.
 func (s *Snapshot) inturn(number uint64, validator common.Address) bool {
 	validators, offset := s.validators(), 0
@@ -0,0 +1,137 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package congress
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Punishment is a validator punished for missing its turn to seal a block.
+type Punishment struct {
+	Number    uint64         `json:"number"`
+	Validator common.Address `json:"validator"`
+}
+
+// Stats is an overview of the consensus at a block, as reported to network
+// monitoring.
+type Stats struct {
+	Validator     bool             `json:"validator"`     // Whether the authorized validator is in the set
+	Validators    []common.Address `json:"validators"`    // Active validator set
+	InturnPercent float64          `json:"inturnPercent"` // Percentage of in-turn blocks among the recent ones
+	Punishments   []*Punishment    `json:"punishments"`   // Punishments of the recent blocks
+	BlacklistSize int              `json:"blacklistSize"` // Number of blacklisted addresses
+}
+
+// Stats gathers the consensus overview at the given block, looking back the
+// given number of blocks for the in-turn percentage and the punishments.
+func (c *Congress) Stats(header *types.Header, blocks uint64) (*Stats, error) {
+	if c.chain == nil || c.stateFn == nil {
+		return nil, errors.New("chain unavailable")
+	}
+	number := header.Number.Uint64()
+	snap, err := c.snapshot(c.chain, number, header.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+	c.lock.RLock()
+	_, validator := snap.Validators[c.validator]
+	validator = validator && c.signFn != nil
+	c.lock.RUnlock()
+
+	stats := &Stats{
+		Validator:   validator,
+		Validators:  snap.validators(),
+		Punishments: make([]*Punishment, 0),
+	}
+	// Walk back the recent blocks, the ones sealed out-of-turn punish the in-turn
+	// validator unless it signed recently, as done at their finalization
+	inturn, blocks, err := walkTurns(c.chain, header, blocks, func(h *types.Header, inturn bool) error {
+		if inturn {
+			return nil
+		}
+		parent, err := c.snapshot(c.chain, h.Number.Uint64()-1, h.ParentHash, nil)
+		if err != nil {
+			return err
+		}
+		if missed, ok := parent.missedValidator(h.Number.Uint64()); ok {
+			stats.Punishments = append(stats.Punishments, &Punishment{Number: h.Number.Uint64(), Validator: missed})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	stats.InturnPercent = inturnPercent(inturn, blocks)
+	// The blacklist in effect is the one the next block is checked against
+	statedb, err := c.stateFn(header.Root)
+	if err != nil {
+		return nil, err
+	}
+	next := &types.Header{
+		ParentHash: header.Hash(),
+		Difficulty: new(big.Int).Set(header.Difficulty),
+		Number:     new(big.Int).Add(header.Number, common.Big1),
+		GasLimit:   header.GasLimit,
+		Time:       header.Time + 1,
+	}
+	blacklist, err := c.getBlacklist(next, statedb)
+	if err != nil {
+		return nil, err
+	}
+	stats.BlacklistSize = len(blacklist)
+	return stats, nil
+}
+
+// walkTurns walks back the given number of blocks ending with the header, down
+// to block 1 at most, calling fn with each of them and whether it was sealed
+// in-turn. It returns the number of in-turn blocks and of walked blocks.
+func walkTurns(chain consensus.ChainHeaderReader, header *types.Header, blocks uint64, fn func(header *types.Header, inturn bool) error) (uint64, uint64, error) {
+	number := header.Number.Uint64()
+	if blocks > number {
+		blocks = number
+	}
+	var inturn uint64
+	for i := uint64(0); i < blocks; i++ {
+		if i > 0 {
+			if header = chain.GetHeader(header.ParentHash, number-i); header == nil {
+				return 0, 0, fmt.Errorf("missing block %d", number-i)
+			}
+		}
+		turn := IsInTurn(header)
+		if turn {
+			inturn++
+		}
+		if err := fn(header, turn); err != nil {
+			return 0, 0, err
+		}
+	}
+	return inturn, blocks, nil
+}
+
+// inturnPercent returns the percentage of in-turn blocks among the given ones.
+func inturnPercent(inturn, blocks uint64) float64 {
+	if blocks == 0 {
+		return 0
+	}
+	return float64(100*inturn) / float64(blocks)
+}
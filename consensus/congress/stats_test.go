@@ -0,0 +1,113 @@
+package congress
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+func TestStats(t *testing.T) {
+	var (
+		a, b          = common.HexToAddress("0x0a"), common.HexToAddress("0x0b")
+		config        = &params.CongressConfig{Epoch: 100}
+		recents       = mustNewARC(8)
+		blacklists, _ = lru.New(8)
+		chain         testHeaderChain
+	)
+	// Block 1 is sealed in-turn by b, blocks 2 and 3 out-of-turn in place of a
+	// and b, the latter having signed recently
+	for i := 0; i <= 3; i++ {
+		header := &types.Header{Number: big.NewInt(int64(i)), Difficulty: diffNoTurn, Extra: epochExtra()}
+		switch i {
+		case 0:
+			header.Extra = epochExtra(a, b)
+		case 1:
+			header.Difficulty = diffInTurn
+		}
+		if i > 0 {
+			header.ParentHash = chain[i-1].Hash()
+		}
+		chain = append(chain, header)
+	}
+	for i, header := range chain {
+		snap := newSnapshot(config, nil, uint64(i), header.Hash(), []common.Address{a, b})
+		if i == 2 {
+			snap.Recents[2] = b
+		}
+		recents.Add(header.Hash(), snap)
+	}
+	head := chain[3]
+	blacklists.Add(head.Hash(), map[common.Address]blacklistDirection{
+		common.HexToAddress("0x01"): DirectionFrom,
+		common.HexToAddress("0x02"): DirectionBoth,
+	})
+	engine := &Congress{config: config, recents: recents, blacklists: blacklists, chain: chain}
+	engine.SetStateFn(func(common.Hash) (*state.StateDB, error) { return mustNewState(t), nil })
+	engine.Authorize(a, func(accounts.Account, string, []byte) ([]byte, error) { return nil, nil }, nil)
+
+	stats, err := engine.Stats(head, 64)
+	if err != nil {
+		t.Fatalf("failed to gather stats: %v", err)
+	}
+	if !stats.Validator || len(stats.Validators) != 2 || stats.Validators[0] != a {
+		t.Fatalf("validators mismatch: %v, %x", stats.Validator, stats.Validators)
+	}
+	if want := float64(100) / 3; stats.InturnPercent != want {
+		t.Fatalf("in-turn percentage mismatch: have %v, want %v", stats.InturnPercent, want)
+	}
+	if len(stats.Punishments) != 1 || *stats.Punishments[0] != (Punishment{Number: 2, Validator: a}) {
+		t.Fatalf("punishments mismatch: %+v", stats.Punishments)
+	}
+	if stats.BlacklistSize != 2 {
+		t.Fatalf("blacklist size mismatch: have %d, want 2", stats.BlacklistSize)
+	}
+	// Only the requested blocks are looked back
+	if stats, err = engine.Stats(head, 1); err != nil || stats.InturnPercent != 0 || len(stats.Punishments) != 0 {
+		t.Fatalf("ranged stats mismatch: %+v, err %v", stats, err)
+	}
+	// The status covers the blocks preceding the head, 1 (in-turn) and 2
+	status, err := (&API{chain: chain, congress: engine}).Status()
+	if err != nil {
+		t.Fatalf("failed to get status: %v", err)
+	}
+	if status.NumBlocks != 2 || status.InturnPercent != 50 {
+		t.Fatalf("status mismatch: %+v", status)
+	}
+}
+
+func TestMissedValidator(t *testing.T) {
+	var (
+		a, b, c = common.HexToAddress("0x0a"), common.HexToAddress("0x0b"), common.HexToAddress("0x0c")
+		config  = &params.CongressConfig{Epoch: 100}
+	)
+	for i, tt := range []struct {
+		number  uint64
+		recents map[uint64]common.Address
+		missed  common.Address
+		ok      bool
+	}{
+		{number: 3, missed: a, ok: true},
+		{number: 4, missed: b, ok: true},
+		{number: 5, missed: c, ok: true},
+		{number: 4, recents: map[uint64]common.Address{3: b}}, // signed recently
+		{number: 4, recents: map[uint64]common.Address{3: c}, missed: b, ok: true},
+	} {
+		snap := newSnapshot(config, nil, tt.number-1, common.Hash{}, []common.Address{c, a, b})
+		for number, val := range tt.recents {
+			snap.Recents[number] = val
+		}
+		if missed, ok := snap.missedValidator(tt.number); missed != tt.missed || ok != tt.ok {
+			t.Errorf("test %d: missed validator mismatch: have %x %v, want %x %v", i, missed, ok, tt.missed, tt.ok)
+		}
+	}
+	empty := newSnapshot(config, nil, 0, common.Hash{}, nil)
+	if _, ok := empty.missedValidator(1); ok {
+		t.Fatalf("validator missed in empty set")
+	}
+}
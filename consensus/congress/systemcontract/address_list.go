@@ -70,3 +70,35 @@ func (s *hardForkAddressList) Execute(state *state.StateDB, header *types.Header
 
 	return
 }
+
+// ruleLocationShift is the bit offset of the address location inside the checkIdx of an
+// event check rule.
+//
+// The uint128 checkIdx of a rule, stored as is by the AddressList contract, packs both
+// where the checked address lives in a log and its index there:
+//    [127 - 64][63 - 0]
+//    [location][ index]
+// Rules with a zero location check topics, as all rules did before the congress
+// governance fork.
+//
+// The packing needs no contract upgrade: the v1 contract (the genesis code of
+// AddressListContractAddr) neither interprets nor bounds the checkIdx beyond its
+// uint128 type, it keys the rules by (eventSig, checkIdx) and returns and emits the
+// value unchanged, so a data rule and a topic rule of the same index are distinct
+// rules. TestRuleCheckIdxOverState asserts this against the v1 code.
+const ruleLocationShift = 64
+
+// ruleIndexMask masks the index out of the checkIdx of an event check rule.
+var ruleIndexMask = new(big.Int).SetUint64(math.MaxUint64)
+
+// PackRuleCheckIdx packs an address location and an index into the checkIdx of a rule.
+func PackRuleCheckIdx(location common.AddressLocation, idx uint64) *big.Int {
+	checkIdx := new(big.Int).Lsh(new(big.Int).SetUint64(uint64(location)), ruleLocationShift)
+	return checkIdx.Or(checkIdx, new(big.Int).SetUint64(idx))
+}
+
+// UnpackRuleCheckIdx splits the checkIdx of a rule into the address location and the index.
+func UnpackRuleCheckIdx(checkIdx *big.Int) (common.AddressLocation, uint64) {
+	location := new(big.Int).Rsh(checkIdx, ruleLocationShift)
+	return common.AddressLocation(location.Uint64()), new(big.Int).And(checkIdx, ruleIndexMask).Uint64()
+}
@@ -0,0 +1,134 @@
+package systemcontract
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract/bindings"
+	"github.com/ethereum/go-ethereum/consensus/congress/vmcaller"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestRuleCheckIdx(t *testing.T) {
+	// Rules without location are topic rules
+	if loc, idx := UnpackRuleCheckIdx(big.NewInt(2)); loc != common.LocationTopic || idx != 2 {
+		t.Errorf("topic rule unpacked wrongly: location %v, index %d", loc, idx)
+	}
+	for _, loc := range []common.AddressLocation{common.LocationTopic, common.LocationData, common.LocationDataArray} {
+		checkIdx := PackRuleCheckIdx(loc, 5)
+		if checkIdx.BitLen() > 128 {
+			t.Fatalf("checkIdx overflows uint128: %v", checkIdx)
+		}
+		if have, idx := UnpackRuleCheckIdx(checkIdx); have != loc || idx != 5 {
+			t.Errorf("location %v: unpacked location %v, index %d", loc, have, idx)
+		}
+	}
+}
+
+func TestRuleCheckIdxOverState(t *testing.T) {
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	var (
+		config = params.AllEthashProtocolChanges
+		header = &types.Header{Number: big.NewInt(1), Difficulty: big.NewInt(1), GasLimit: params.GenesisGasLimit}
+		chain  = &testChainContext{ethash.NewFaker()}
+		fork   = new(hardForkAddressList)
+	)
+	if err := fork.Update(config, header.Number, statedb); err != nil {
+		t.Fatalf("failed to deploy address list: %v", err)
+	}
+	if err := fork.Execute(statedb, header, chain, config); err != nil {
+		t.Fatalf("failed to initialize address list: %v", err)
+	}
+	contract, err := bindings.NewAddressListCaller(AddressListContractAddr, vmcaller.NewContractCaller(statedb, header, chain, config))
+	if err != nil {
+		t.Fatalf("failed to bind address list: %v", err)
+	}
+	filterer, err := bindings.NewAddressListFilterer(AddressListContractAddr, nil)
+	if err != nil {
+		t.Fatalf("failed to bind address list events: %v", err)
+	}
+	send := func(i int, method string, args ...interface{}) []*types.Log {
+		data, err := GetInteractiveABI()[AddressListContractName].Pack(method, args...)
+		if err != nil {
+			t.Fatalf("failed to pack %s: %v", method, err)
+		}
+		txHash := common.Hash{byte(i), 0x01}
+		statedb.Prepare(txHash, i)
+		msg := vmcaller.NewLegacyMessage(devAdminTestnet, &AddressListContractAddr, 0, new(big.Int), math.MaxUint64, new(big.Int), data, false)
+		if _, err := vmcaller.ExecuteMsg(msg, statedb, header, chain, config); err != nil {
+			t.Fatalf("failed to %s: %v", method, err)
+		}
+		return statedb.GetLogs(txHash, common.Hash{})
+	}
+	base, err := contract.RulesLen(nil)
+	if err != nil {
+		t.Fatalf("failed to get the initial rules: %v", err)
+	}
+	// The v1 contract neither interprets nor bounds the checkIdx beyond its uint128
+	// type, so a packed location and index must come back unchanged from every
+	// getter and event, and must address the rule when removing it.
+	rules := []struct {
+		sig      common.Hash
+		location common.AddressLocation
+		idx      uint64
+	}{
+		{common.Hash{0x40}, common.LocationTopic, 2},
+		{common.Hash{0x41}, common.LocationData, 0},
+		{common.Hash{0x42}, common.LocationDataArray, 3},
+		{common.Hash{0x43}, common.LocationDataArray, math.MaxUint64},
+	}
+	for i, rule := range rules {
+		checkIdx := PackRuleCheckIdx(rule.location, rule.idx)
+		logs := send(i, "addOrUpdateRule", rule.sig, checkIdx, uint8(common.CheckTo))
+		if len(logs) != 1 {
+			t.Fatalf("rule %d: have %d logs, want 1", i, len(logs))
+		}
+		added, err := filterer.ParseRuleAdded(*logs[0])
+		if err != nil {
+			t.Fatalf("rule %d: failed to parse event: %v", i, err)
+		}
+		if loc, idx := UnpackRuleCheckIdx(added.CheckIdx); loc != rule.location || idx != rule.idx {
+			t.Errorf("rule %d: event location %v, index %d", i, loc, idx)
+		}
+		_, stored, ct, err := contract.GetRuleByKey(nil, rule.sig, checkIdx)
+		if err != nil {
+			t.Fatalf("rule %d: failed to get rule by key: %v", i, err)
+		}
+		if loc, idx := UnpackRuleCheckIdx(stored); loc != rule.location || idx != rule.idx || common.AddressCheckType(ct) != common.CheckTo {
+			t.Errorf("rule %d: stored location %v, index %d, check %v", i, loc, idx, common.AddressCheckType(ct))
+		}
+		sig, stored, _, err := contract.GetRuleByIndex(nil, base+uint32(i))
+		if err != nil {
+			t.Fatalf("rule %d: failed to get rule by index: %v", i, err)
+		}
+		if loc, idx := UnpackRuleCheckIdx(stored); sig != rule.sig || loc != rule.location || idx != rule.idx {
+			t.Errorf("rule %d: indexed rule %x, location %v, index %d", i, sig, loc, idx)
+		}
+	}
+	// The same index at another location is another rule
+	if _, stored, _, err := contract.GetRuleByKey(nil, rules[2].sig, PackRuleCheckIdx(common.LocationData, rules[2].idx)); err != nil {
+		t.Fatalf("failed to get missing rule: %v", err)
+	} else if stored.Sign() != 0 {
+		t.Errorf("rule found at another location: %v", stored)
+	}
+	logs := send(len(rules), "removeRule", rules[2].sig, PackRuleCheckIdx(rules[2].location, rules[2].idx))
+	if len(logs) != 1 {
+		t.Fatalf("have %d removal logs, want 1", len(logs))
+	}
+	removed, err := filterer.ParseRuleRemoved(*logs[0])
+	if err != nil {
+		t.Fatalf("failed to parse removal: %v", err)
+	}
+	if loc, idx := UnpackRuleCheckIdx(removed.CheckIdx); loc != rules[2].location || idx != rules[2].idx {
+		t.Errorf("removal location %v, index %d", loc, idx)
+	}
+	if n, err := contract.RulesLen(nil); err != nil || int(n-base) != len(rules)-1 {
+		t.Errorf("rules left: have %d (err %v), want %d", n-base, err, len(rules)-1)
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package bindings contains the generated Go bindings of the congress system
+// contracts, generated from the interfaces in the abi directory.
+//
+// The bindings can be backed by an RPC client, e.g. ethclient.Client, or by
+// vmcaller.ContractCaller to call the contracts against a state.StateDB.
+package bindings
+
+//go:generate abigen --abi abi/validators.abi --pkg bindings --type Validators --out validators.go
+//go:generate abigen --abi abi/punish.abi --pkg bindings --type Punish --out punish.go
+//go:generate abigen --abi abi/sysgov.abi --pkg bindings --type SysGov --out sysgov.go
+//go:generate abigen --abi abi/address_list.abi --pkg bindings --type AddressList --out address_list.go
+//go:generate abigen --abi abi/user_address_list.abi --pkg bindings --type UserAddressList --out user_address_list.go
+//go:generate abigen --abi abi/vote_pool.abi --pkg bindings --type VotePool --out vote_pool.go
@@ -0,0 +1,63 @@
+package systemcontract
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract/bindings"
+	"github.com/ethereum/go-ethereum/consensus/congress/vmcaller"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+type testChainContext struct{ engine consensus.Engine }
+
+func (cc *testChainContext) Engine() consensus.Engine                    { return cc.engine }
+func (cc *testChainContext) GetHeader(common.Hash, uint64) *types.Header { return nil }
+
+func TestBindingsOverState(t *testing.T) {
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	var (
+		config = params.AllEthashProtocolChanges
+		header = &types.Header{Number: big.NewInt(1), Difficulty: big.NewInt(1), GasLimit: params.GenesisGasLimit}
+		chain  = &testChainContext{ethash.NewFaker()}
+		fork   = new(hardForkAddressList)
+	)
+	if err := fork.Update(config, header.Number, statedb); err != nil {
+		t.Fatalf("failed to deploy address list: %v", err)
+	}
+	if err := fork.Execute(statedb, header, chain, config); err != nil {
+		t.Fatalf("failed to initialize address list: %v", err)
+	}
+	contract, err := bindings.NewAddressListCaller(AddressListContractAddr, vmcaller.NewContractCaller(statedb, header, chain, config))
+	if err != nil {
+		t.Fatalf("failed to bind address list: %v", err)
+	}
+	if admin, err := contract.Admin(nil); err != nil || admin != devAdminTestnet {
+		t.Errorf("admin mismatch: have %x, want %x, err %v", admin, devAdminTestnet, err)
+	}
+	// The default event check rules are created on initialization
+	n, err := contract.RulesLen(nil)
+	if err != nil || n == 0 {
+		t.Fatalf("rules length mismatch: have %d, err %v", n, err)
+	}
+	if sig, _, _, err := contract.GetRuleByIndex(nil, n-1); err != nil || sig == (common.Hash{}) {
+		t.Errorf("rule %d mismatch: have %x, err %v", n-1, sig, err)
+	}
+	if dev, err := contract.IsDeveloper(nil, common.Address{0x01}); err != nil || dev {
+		t.Errorf("developer mismatch: have %v, want false, err %v", dev, err)
+	}
+	// Calls to a missing contract report the missing code
+	validators, err := bindings.NewValidatorsCaller(ValidatorsContractAddr, vmcaller.NewContractCaller(statedb, header, chain, config))
+	if err != nil {
+		t.Fatalf("failed to bind validators: %v", err)
+	}
+	if _, err := validators.GetTopValidators(nil); err == nil {
+		t.Error("call to a missing contract succeeded")
+	}
+}
@@ -0,0 +1,9 @@
+package systemcontract
+
+// States of the vote pool of a validator, as enumerated by the VotePool contract.
+const (
+	VotePoolIdle  uint8 = iota // Not enough margin to be ranked
+	VotePoolReady              // Ranked for the validator set
+	VotePoolPause              // Paused by its manager
+	VotePoolJail               // Jailed by a punishment, until the jail period is over
+)
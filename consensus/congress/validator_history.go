@@ -0,0 +1,313 @@
+package congress
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	validatorSetChanSize   = 10   // Size of the channel listening to chain head events
+	maxValidatorSetEpochs  = 1024 // Maximum number of epochs served by a single history request
+	maxValidatorSetCatchup = 16   // Maximum number of epochs reported after a head jump
+)
+
+// Reasons of a validator set change.
+const (
+	ValidatorReasonRanking = "ranking" // The validator entered or left the top of the ranking
+	ValidatorReasonJailed  = "jailed"  // The validator was removed from the ranking by a punishment
+	ValidatorReasonPaused  = "paused"  // The validator was paused by its manager
+)
+
+// ValidatorChange is a validator added to or removed from the active set at an
+// epoch, with the reason of the change. The reason of a removal is left empty if
+// the state of the epoch is not available or doesn't tell it, e.g. for a
+// validator which lost its margin.
+type ValidatorChange struct {
+	Address common.Address `json:"address"`
+	Reason  string         `json:"reason,omitempty"`
+}
+
+// ValidatorSetChange is the change of the active validator set done at an epoch
+// block, as recorded in the extra data of the epoch headers.
+type ValidatorSetChange struct {
+	BlockNumber uint64             `json:"blockNumber"`
+	BlockHash   common.Hash        `json:"blockHash"`
+	Validators  []common.Address   `json:"validators"`
+	Added       []*ValidatorChange `json:"added"`
+	Removed     []*ValidatorChange `json:"removed"`
+}
+
+// validatorSetChange builds the validator set change of the given epoch header by
+// comparing its validators with the ones in effect before. It returns nil if the
+// set didn't change.
+func (c *Congress) validatorSetChange(chain consensus.ChainHeaderReader, header *types.Header) (*ValidatorSetChange, error) {
+	number := header.Number.Uint64()
+	if number == 0 {
+		return nil, errors.New("not an epoch block")
+	}
+	snap, err := c.snapshot(chain, number-1, header.ParentHash, nil)
+	if err != nil {
+		return nil, err
+	}
+	if number%snap.params().Epoch != 0 {
+		return nil, errors.New("not an epoch block")
+	}
+	validators, err := EpochValidators(header)
+	if err != nil {
+		return nil, err
+	}
+	prevValidators := snap.validators()
+	var (
+		current  = make(map[common.Address]struct{})
+		previous = make(map[common.Address]struct{})
+		change   = &ValidatorSetChange{
+			BlockNumber: number,
+			BlockHash:   header.Hash(),
+			Validators:  validators,
+			Added:       make([]*ValidatorChange, 0),
+			Removed:     make([]*ValidatorChange, 0),
+		}
+	)
+	for _, val := range validators {
+		current[val] = struct{}{}
+	}
+	for _, val := range prevValidators {
+		previous[val] = struct{}{}
+		if _, ok := current[val]; !ok {
+			change.Removed = append(change.Removed, &ValidatorChange{Address: val})
+		}
+	}
+	for _, val := range validators {
+		if _, ok := previous[val]; !ok {
+			change.Added = append(change.Added, &ValidatorChange{Address: val, Reason: ValidatorReasonRanking})
+		}
+	}
+	if len(change.Added) == 0 && len(change.Removed) == 0 {
+		return nil, nil
+	}
+	if len(change.Removed) > 0 {
+		c.resolveRemovalReasons(chain, header, change.Removed)
+	}
+	return change, nil
+}
+
+// resolveRemovalReasons tells why the validators were removed from the set, from
+// the state of their vote pools at the parent of the epoch block. The new set is
+// the top of the ranking at that state, so a removed validator still ready to be
+// ranked lost its place, while the others were jailed or paused. The reason of a
+// validator without enough margin is left empty.
+func (c *Congress) resolveRemovalReasons(chain consensus.ChainHeaderReader, header *types.Header, removed []*ValidatorChange) {
+	if c.stateFn == nil {
+		return
+	}
+	parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	if parent == nil {
+		return
+	}
+	statedb, err := c.stateFn(parent.Root)
+	if err != nil {
+		log.Debug("State of epoch unavailable for validator changes", "number", header.Number, "err", err)
+		return
+	}
+	chainContext := newMinimalChainContext(c)
+	contract, err := c.validatorsContract(parent, statedb, chainContext)
+	if err != nil {
+		return
+	}
+	for _, change := range removed {
+		pool, err := contract.VotePools(nil, change.Address)
+		if err != nil || pool == (common.Address{}) {
+			continue
+		}
+		poolContract, err := c.votePoolContract(parent, statedb, chainContext, pool)
+		if err != nil {
+			continue
+		}
+		state, err := poolContract.State(nil)
+		if err != nil {
+			log.Debug("Failed to get vote pool state", "validator", change.Address, "err", err)
+			continue
+		}
+		switch state {
+		case systemcontract.VotePoolReady:
+			change.Reason = ValidatorReasonRanking
+		case systemcontract.VotePoolJail:
+			change.Reason = ValidatorReasonJailed
+		case systemcontract.VotePoolPause:
+			change.Reason = ValidatorReasonPaused
+		}
+	}
+}
+
+// validatorSetChain is the part of the blockchain the validator set feed follows.
+type validatorSetChain interface {
+	consensus.ChainHeaderReader
+	SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription
+}
+
+// validatorSetFeed reports the changes of the validator set done by the epoch
+// blocks which become the head of the chain.
+type validatorSetFeed struct {
+	feed  event.Feed
+	scope event.SubscriptionScope
+
+	sub  event.Subscription
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// StartValidatorSetFeed starts reporting the validator set changes of the given chain.
+func (c *Congress) StartValidatorSetFeed(chain validatorSetChain) {
+	f := &validatorSetFeed{quit: make(chan struct{})}
+	heads := make(chan core.ChainHeadEvent, validatorSetChanSize)
+	f.sub = chain.SubscribeChainHeadEvent(heads)
+
+	f.wg.Add(1)
+	go c.validatorSetLoop(f, chain, heads)
+	c.validatorSets = f
+}
+
+// validatorSetLoop reports the changes of the epochs between the last processed
+// head and the new one.
+func (c *Congress) validatorSetLoop(f *validatorSetFeed, chain validatorSetChain, heads chan core.ChainHeadEvent) {
+	defer f.wg.Done()
+
+	last := chain.CurrentHeader()
+	for {
+		select {
+		case ev := <-heads:
+			head := ev.Block.Header()
+			for _, header := range c.newEpochs(chain, last, head) {
+				change, err := c.validatorSetChange(chain, header)
+				if err != nil {
+					log.Warn("Failed to build validator set change", "number", header.Number, "err", err)
+					continue
+				}
+				if validators, err := EpochValidators(header); err == nil {
+					markValidatorSet(len(validators), change)
+				}
+				if change != nil {
+					f.feed.Send(change)
+				}
+			}
+			last = head
+
+		case <-f.sub.Err():
+			return
+		case <-f.quit:
+			return
+		}
+	}
+}
+
+// newEpochs returns the epoch blocks which became canonical when the head moved
+// from last to head. After a reorg, these are the epoch blocks of the new chain
+// past the common ancestor, whether the new chain is shorter or longer. Only the
+// latest ones are returned after a head jump (e.g. while syncing).
+func (c *Congress) newEpochs(chain consensus.ChainHeaderReader, last, head *types.Header) []*types.Header {
+	from := last.Number.Uint64() + 1
+	if head.ParentHash != last.Hash() {
+		if ancestor := findCommonAncestor(chain, last, head); ancestor != nil {
+			from = ancestor.Number.Uint64() + 1
+		} else {
+			log.Warn("Failed to find common ancestor of heads", "last", last.Number, "head", head.Number)
+			from = head.Number.Uint64()
+		}
+	}
+	var epochs []*types.Header
+	err := c.IterateEpochs(chain, from, head.Number.Uint64(), func(header *types.Header) bool {
+		epochs = append(epochs, header)
+		return true
+	})
+	if err != nil {
+		log.Warn("Failed to find epoch blocks", "from", from, "to", head.Number, "err", err)
+	}
+	if len(epochs) > maxValidatorSetCatchup {
+		epochs = epochs[len(epochs)-maxValidatorSetCatchup:]
+	}
+	return epochs
+}
+
+// findCommonAncestor returns the most recent common ancestor of the given headers,
+// or nil if it can't be found.
+func findCommonAncestor(chain consensus.ChainHeaderReader, a, b *types.Header) *types.Header {
+	for a.Number.Uint64() > b.Number.Uint64() {
+		if a = chain.GetHeader(a.ParentHash, a.Number.Uint64()-1); a == nil {
+			return nil
+		}
+	}
+	for b.Number.Uint64() > a.Number.Uint64() {
+		if b = chain.GetHeader(b.ParentHash, b.Number.Uint64()-1); b == nil {
+			return nil
+		}
+	}
+	for a.Hash() != b.Hash() {
+		if a.Number.Uint64() == 0 {
+			return nil
+		}
+		if a = chain.GetHeader(a.ParentHash, a.Number.Uint64()-1); a == nil {
+			return nil
+		}
+		if b = chain.GetHeader(b.ParentHash, b.Number.Uint64()-1); b == nil {
+			return nil
+		}
+	}
+	return a
+}
+
+func (f *validatorSetFeed) close() {
+	f.sub.Unsubscribe()
+	close(f.quit)
+	f.wg.Wait()
+	f.scope.Close()
+}
+
+// SubscribeValidatorSetChanges registers a subscription for the validator set
+// changes of the chain. It returns nil if the feed isn't running.
+func (c *Congress) SubscribeValidatorSetChanges(ch chan<- *ValidatorSetChange) event.Subscription {
+	if c.validatorSets == nil {
+		return nil
+	}
+	return c.validatorSets.scope.Track(c.validatorSets.feed.Subscribe(ch))
+}
+
+// IterateEpochs calls fn with the canonical epoch blocks between the from and to
+// blocks (both inclusive) in chain order, until fn returns false. The epoch
+// blocks are found with the epoch length in effect at each of them.
+func (c *Congress) IterateEpochs(chain consensus.ChainHeaderReader, from, to uint64, fn func(*types.Header) bool) error {
+	if from == 0 {
+		from = 1
+	}
+	if from > to {
+		return nil
+	}
+	parent := chain.GetHeaderByNumber(from - 1)
+	if parent == nil {
+		return errUnknownBlock
+	}
+	p, err := c.Params(chain, parent)
+	if err != nil {
+		return err
+	}
+	for number := (from + p.Epoch - 1) / p.Epoch * p.Epoch; number <= to; {
+		header := chain.GetHeaderByNumber(number)
+		if header == nil {
+			return errUnknownBlock
+		}
+		if !fn(header) {
+			return nil
+		}
+		// The epoch block sets the epoch length of the next one
+		epoch := c.epochParams(header).Epoch
+		number = (number/epoch + 1) * epoch
+	}
+	return nil
+}
@@ -0,0 +1,279 @@
+package congress
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress/systemcontract"
+	"github.com/ethereum/go-ethereum/consensus/congress/vmcaller"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// testHeaderChain is a canonical chain of headers for the epoch tests.
+type testHeaderChain []*types.Header
+
+func (c testHeaderChain) Config() *params.ChainConfig  { return params.TestChainConfig }
+func (c testHeaderChain) CurrentHeader() *types.Header { return c[len(c)-1] }
+
+func (c testHeaderChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	if header := c.GetHeaderByNumber(number); header != nil && header.Hash() == hash {
+		return header
+	}
+	return nil
+}
+
+func (c testHeaderChain) GetHeaderByNumber(number uint64) *types.Header {
+	if number >= uint64(len(c)) {
+		return nil
+	}
+	return c[number]
+}
+
+func (c testHeaderChain) GetHeaderByHash(hash common.Hash) *types.Header {
+	for _, header := range c {
+		if header.Hash() == hash {
+			return header
+		}
+	}
+	return nil
+}
+
+func mustNewARC(size int) *lru.ARCCache {
+	cache, err := lru.NewARC(size)
+	if err != nil {
+		panic(err)
+	}
+	return cache
+}
+
+func TestValidatorSetChange(t *testing.T) {
+	var (
+		a, b, c = common.HexToAddress("0x0a"), common.HexToAddress("0x0b"), common.HexToAddress("0x0c")
+		config  = &params.CongressConfig{Epoch: 2}
+		recents = mustNewARC(8)
+		engine  = &Congress{config: config, recents: recents}
+		chain   testHeaderChain
+	)
+	for i := 0; i <= 6; i++ {
+		header := &types.Header{Number: big.NewInt(int64(i)), Extra: epochExtra()}
+		switch i {
+		case 0, 2:
+			header.Extra = epochExtra(a, b)
+		case 4, 6:
+			header.Extra = epochExtra(a, c)
+		}
+		if i > 0 {
+			header.ParentHash = chain[i-1].Hash()
+		}
+		chain = append(chain, header)
+	}
+	// Seed the snapshots preceding the checked blocks
+	for i := 1; i <= 3; i++ {
+		recents.Add(chain[i].Hash(), newSnapshot(config, nil, uint64(i), chain[i].Hash(), []common.Address{a, b}))
+	}
+	if change, err := engine.validatorSetChange(chain, chain[2]); err != nil || change != nil {
+		t.Fatalf("unchanged epoch reported a change: %v, err %v", change, err)
+	}
+	change, err := engine.validatorSetChange(chain, chain[4])
+	if err != nil {
+		t.Fatalf("failed to build validator set change: %v", err)
+	}
+	if len(change.Added) != 1 || change.Added[0].Address != c || change.Added[0].Reason != ValidatorReasonRanking {
+		t.Fatalf("added validators mismatch: %+v", change.Added)
+	}
+	// Without state, the reason of a removal is unknown
+	if len(change.Removed) != 1 || change.Removed[0].Address != b || change.Removed[0].Reason != "" {
+		t.Fatalf("removed validators mismatch: %+v", change.Removed)
+	}
+	if _, err := engine.validatorSetChange(chain, chain[3]); err == nil {
+		t.Fatalf("non-epoch block accepted")
+	}
+}
+
+// systemContracts is the state of the system contracts of the example genesis,
+// initialized with a single validator managed by the admin.
+type systemContracts struct {
+	engine  *Congress
+	statedb *state.StateDB
+	header  *types.Header
+	admin   common.Address
+}
+
+func newSystemContracts(t *testing.T) *systemContracts {
+	data, err := ioutil.ReadFile("../../example-genesis.json")
+	if err != nil {
+		t.Fatalf("failed to read genesis: %v", err)
+	}
+	genesis := new(core.Genesis)
+	if err := json.Unmarshal(data, genesis); err != nil {
+		t.Fatalf("failed to parse genesis: %v", err)
+	}
+	statedb := mustNewState(t)
+	for addr, account := range genesis.Alloc {
+		statedb.SetBalance(addr, account.Balance)
+		statedb.SetCode(addr, account.Code)
+		for key, value := range account.Storage {
+			statedb.SetState(addr, key, value)
+		}
+	}
+	sc := &systemContracts{
+		engine:  &Congress{config: genesis.Config.Congress, chainConfig: genesis.Config, abi: systemcontract.GetInteractiveABI()},
+		statedb: statedb,
+		header:  &types.Header{Number: big.NewInt(1), Difficulty: diffInTurn, GasLimit: genesis.GasLimit},
+		admin:   systemcontract.GetAdminByChainId(genesis.Config.ChainID),
+	}
+	statedb.SetBalance(sc.admin, new(big.Int).Mul(big.NewInt(1000000), big.NewInt(params.Ether)))
+
+	validators, managers := []common.Address{common.HexToAddress("0x99")}, []common.Address{sc.admin}
+	sc.call(t, common.Address{}, systemcontract.ValidatorsContractAddr, nil, sc.pack(t, systemcontract.ValidatorsContractName, "initialize", validators, managers, sc.admin))
+	sc.call(t, common.Address{}, systemcontract.PunishContractAddr, nil, sc.pack(t, systemcontract.PunishContractName, "initialize"))
+	return sc
+}
+
+// pack packs a call of a system contract method, failing the test if it fails.
+func (sc *systemContracts) pack(t *testing.T, contract, method string, args ...interface{}) []byte {
+	t.Helper()
+
+	data, err := sc.engine.abi[contract].Pack(method, args...)
+	if err != nil {
+		t.Fatalf("failed to pack %s: %v", method, err)
+	}
+	return data
+}
+
+// call executes a contract call, failing the test if it fails.
+func (sc *systemContracts) call(t *testing.T, from, to common.Address, value *big.Int, data []byte) {
+	t.Helper()
+
+	if value == nil {
+		value = new(big.Int)
+	}
+	msg := vmcaller.NewLegacyMessage(from, &to, 0, value, math.MaxUint64, new(big.Int), data, false)
+	if _, err := vmcaller.ExecuteMsg(msg, sc.statedb, sc.header, newMinimalChainContext(sc.engine), sc.engine.chainConfig); err != nil {
+		t.Fatalf("failed to call %x: %v", data, err)
+	}
+}
+
+func TestResolveRemovalReasons(t *testing.T) {
+	var (
+		sc                           = newSystemContracts(t)
+		jailed, paused, ranked, idle = common.HexToAddress("0x0a"), common.HexToAddress("0x0b"), common.HexToAddress("0x0c"), common.HexToAddress("0x0d")
+		unknown                      = common.HexToAddress("0x0e")
+	)
+	contract, err := sc.engine.validatorsContract(sc.header, sc.statedb, newMinimalChainContext(sc.engine))
+	if err != nil {
+		t.Fatalf("failed to bind validators: %v", err)
+	}
+	margin, err := contract.PoaMinMargin(nil)
+	if err != nil {
+		t.Fatalf("failed to get minimal margin: %v", err)
+	}
+	pools := make(map[common.Address]common.Address)
+	for _, val := range []common.Address{jailed, paused, ranked, idle} {
+		sc.call(t, sc.admin, systemcontract.ValidatorsContractAddr, nil, sc.pack(t, systemcontract.ValidatorsContractName, "addValidator", val, sc.admin, big.NewInt(500), uint8(1)))
+		if pools[val], err = contract.VotePools(nil, val); err != nil {
+			t.Fatalf("failed to get vote pool: %v", err)
+		}
+	}
+	// Rank all but the one without margin, then jail and pause two of them
+	for _, val := range []common.Address{jailed, paused, ranked} {
+		sc.call(t, sc.admin, pools[val], margin, crypto.Keccak256([]byte("addMargin()"))[:4])
+	}
+	sc.call(t, systemcontract.PunishContractAddr, pools[jailed], nil, crypto.Keccak256([]byte("punish()"))[:4])
+	sc.call(t, sc.admin, systemcontract.ValidatorsContractAddr, nil, sc.pack(t, systemcontract.ValidatorsContractName, "updateValidatorState", paused, true))
+
+	// The state is read at the parent of the epoch block
+	var (
+		parent = &types.Header{Number: big.NewInt(1), Difficulty: diffInTurn, GasLimit: sc.header.GasLimit, Root: common.HexToHash("0x01")}
+		header = &types.Header{Number: big.NewInt(2), ParentHash: parent.Hash()}
+		chain  = testHeaderChain{&types.Header{Number: big.NewInt(0)}, parent, header}
+	)
+	sc.engine.stateFn = func(root common.Hash) (*state.StateDB, error) { return sc.statedb, nil }
+
+	removed := []*ValidatorChange{{Address: jailed}, {Address: paused}, {Address: ranked}, {Address: idle}, {Address: unknown}}
+	sc.engine.resolveRemovalReasons(chain, header, removed)
+	want := []string{ValidatorReasonJailed, ValidatorReasonPaused, ValidatorReasonRanking, "", ""}
+	for i, change := range removed {
+		if change.Reason != want[i] {
+			t.Errorf("validator %x: reason mismatch: have %q, want %q", change.Address, change.Reason, want[i])
+		}
+	}
+}
+
+// forkedHeaderChain is a canonical chain of headers which also knows the headers
+// of a side chain.
+type forkedHeaderChain struct {
+	testHeaderChain
+	side []*types.Header
+}
+
+func (c forkedHeaderChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	for _, header := range c.side {
+		if header.Hash() == hash {
+			return header
+		}
+	}
+	return c.testHeaderChain.GetHeader(hash, number)
+}
+
+func TestNewEpochs(t *testing.T) {
+	var (
+		a, b   = common.HexToAddress("0x0a"), common.HexToAddress("0x0b")
+		config = &params.CongressConfig{Epoch: 2}
+		engine = &Congress{config: config, recents: mustNewARC(16)}
+		chain  forkedHeaderChain
+	)
+	for i := 0; i <= 6; i++ {
+		header := &types.Header{Number: big.NewInt(int64(i)), Extra: epochExtra()}
+		if i%2 == 0 {
+			header.Extra = epochExtra(a, b)
+		}
+		if i > 0 {
+			header.ParentHash = chain.testHeaderChain[i-1].Hash()
+		}
+		chain.testHeaderChain = append(chain.testHeaderChain, header)
+		engine.recents.Add(header.Hash(), newSnapshot(config, nil, uint64(i), header.Hash(), []common.Address{a, b}))
+	}
+	// The side chain forks off after block 2 and is reorged out
+	for i := 3; i <= 4; i++ {
+		header := &types.Header{Number: big.NewInt(int64(i)), Extra: epochExtra(), Time: 1}
+		if i == 3 {
+			header.ParentHash = chain.testHeaderChain[2].Hash()
+		} else {
+			header.ParentHash = chain.side[0].Hash()
+		}
+		chain.side = append(chain.side, header)
+	}
+	numbers := func(headers []*types.Header) []uint64 {
+		var numbers []uint64
+		for _, header := range headers {
+			numbers = append(numbers, header.Number.Uint64())
+		}
+		return numbers
+	}
+	for i, tt := range []struct {
+		last, head *types.Header
+		want       []uint64
+	}{
+		{chain.testHeaderChain[3], chain.testHeaderChain[4], []uint64{4}},    // extension
+		{chain.testHeaderChain[2], chain.testHeaderChain[6], []uint64{4, 6}}, // batch insert
+		{chain.testHeaderChain[4], chain.testHeaderChain[4], nil},            // same head
+		{chain.side[1], chain.testHeaderChain[6], []uint64{4, 6}},            // reorg onto a longer chain
+		{chain.side[1], chain.testHeaderChain[4], []uint64{4}},               // reorg onto a chain as long
+		{chain.testHeaderChain[6], chain.testHeaderChain[4], nil},            // rewind
+	} {
+		if have := numbers(engine.newEpochs(chain, tt.last, tt.head)); !reflect.DeepEqual(have, tt.want) {
+			t.Errorf("test %d: epochs mismatch: have %v, want %v", i, have, tt.want)
+		}
+	}
+}
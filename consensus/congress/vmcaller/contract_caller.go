@@ -0,0 +1,48 @@
+package vmcaller
+
+import (
+	"context"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ContractCaller implements bind.ContractCaller by executing the calls against
+// a state, so the generated contract bindings can be used by the consensus engine.
+// The block number of the calls is ignored, they always run on top of the state
+// in the context of the header.
+type ContractCaller struct {
+	state        *state.StateDB
+	header       *types.Header
+	chainContext core.ChainContext
+	chainConfig  *params.ChainConfig
+}
+
+// NewContractCaller creates a contract caller executing on the given state. The
+// state is modified by the calls, callers wanting to keep it should pass a copy.
+func NewContractCaller(state *state.StateDB, header *types.Header, chainContext core.ChainContext, chainConfig *params.ChainConfig) *ContractCaller {
+	return &ContractCaller{
+		state:        state,
+		header:       header,
+		chainContext: chainContext,
+		chainConfig:  chainConfig,
+	}
+}
+
+// CodeAt returns the code of the given account in the state.
+func (c *ContractCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return c.state.GetCode(contract), nil
+}
+
+// CallContract executes the call as a system message from the coinbase of the
+// header, without any gas limit or fee.
+func (c *ContractCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	msg := NewLegacyMessage(c.header.Coinbase, call.To, 0, new(big.Int), math.MaxUint64, new(big.Int), call.Data, false)
+	return ExecuteMsg(msg, c.state, c.header, c.chainContext, c.chainConfig)
+}
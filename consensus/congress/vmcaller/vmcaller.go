@@ -2,6 +2,7 @@ package vmcaller
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -13,8 +14,21 @@ import (
 
 // ExecuteMsg executes transaction sent to system contracts.
 func ExecuteMsg(msg core.Message, state *state.StateDB, header *types.Header, chainContext core.ChainContext, chainConfig *params.ChainConfig) (ret []byte, err error) {
+	return ExecuteTracedMsg("", nil, msg, state, header, chainContext, chainConfig)
+}
+
+// ExecuteTracedMsg executes transaction sent to system contracts like ExecuteMsg,
+// reporting the call to the tracer under the given method name if it's not nil.
+func ExecuteTracedMsg(method string, tracer consensus.SysCallTracer, msg core.Message, state *state.StateDB, header *types.Header, chainContext core.ChainContext, chainConfig *params.ChainConfig) (ret []byte, err error) {
+	var config vm.Config
+	if tracer != nil {
+		if logger := tracer.StartSysCall(method); logger != nil {
+			config = vm.Config{Debug: true, Tracer: logger}
+		}
+		defer func() { tracer.EndSysCall(method, ret, err) }()
+	}
 	blockContext := core.NewEVMBlockContext(header, chainContext, nil)
-	vmenv := vm.NewEVM(blockContext, core.NewEVMTxContext(msg), state, chainConfig, vm.Config{})
+	vmenv := vm.NewEVM(blockContext, core.NewEVMTxContext(msg), state, chainConfig, config)
 
 	ret, _, err = vmenv.Call(vm.AccountRef(msg.From()), *msg.To(), msg.Data(), msg.Gas(), msg.Value())
 	// Finalise the statedb so any changes can take effect,
@@ -162,6 +162,34 @@ type PoSA interface {
 	// ApplySysTx applies a system-transaction using a given evm,
 	// the main purpose of this method is for tracing a system-transaction.
 	ApplySysTx(evm *vm.EVM, state *state.StateDB, txIndex int, sender common.Address, tx *types.Transaction) (ret []byte, vmerr error, err error)
+
+	// TraceTxPolicy runs the same policy checks as ValidateTx against the given
+	// message fields, reporting every check to the hook.
+	TraceTxPolicy(sender common.Address, to *common.Address, value *big.Int, data []byte, header *types.Header, parentState *state.StateDB, hook types.PolicyHook) error
+
+	// TraceFinalizeCalls executes the system contract calls made when finalizing
+	// a block before its system-transactions (e.g. block reward distribution,
+	// punishment, epoch updates), reporting each of them to the tracer.
+	TraceFinalizeCalls(chain ChainHeaderReader, header *types.Header, state *state.StateDB, hasTxs bool, tracer SysCallTracer) error
+
+	// MaxReorgDepth returns the default maximum number of blocks a chain
+	// reorganisation from the given head may drop.
+	MaxReorgDepth(chain ChainHeaderReader, header *types.Header) uint64
+
+	// TraceFinishProposals executes the system contract calls finishing the
+	// proposals of the given system-transactions, reporting each of them to the tracer.
+	TraceFinishProposals(chain ChainHeaderReader, header *types.Header, state *state.StateDB, sysTxs []*types.Transaction, tracer SysCallTracer) error
+}
+
+// SysCallTracer traces the system contract calls a PoSA engine makes outside of
+// any transaction.
+type SysCallTracer interface {
+	// StartSysCall is called before executing a system call, returning the
+	// logger to execute it with, or nil to execute it without tracing.
+	StartSysCall(method string) vm.EVMLogger
+
+	// EndSysCall is called after the system call returned.
+	EndSysCall(method string, ret []byte, err error)
 }
 
 type StateReader interface {
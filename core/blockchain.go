@@ -78,6 +78,7 @@ var (
 	blockReorgAddMeter      = metrics.NewRegisteredMeter("chain/reorg/add", nil)
 	blockReorgDropMeter     = metrics.NewRegisteredMeter("chain/reorg/drop", nil)
 	blockReorgInvalidatedTx = metrics.NewRegisteredMeter("chain/reorg/invalidTx", nil)
+	blockReorgRefusedMeter  = metrics.NewRegisteredMeter("chain/reorg/refused", nil)
 
 	blockPrefetchExecuteTimer   = metrics.NewRegisteredTimer("chain/prefetch/executes", nil)
 	blockPrefetchInterruptMeter = metrics.NewRegisteredMeter("chain/prefetch/interrupts", nil)
@@ -92,6 +93,7 @@ const (
 	receiptsCacheLimit  = 32
 	txLookupCacheLimit  = 1024
 	maxFutureBlocks     = 256
+	maxRefusedBlocks    = 1024
 	maxTimeFutureBlocks = 30
 	TriesInMemory       = 128
 
@@ -184,6 +186,7 @@ type BlockChain struct {
 	chainHeadFeed event.Feed
 	logsFeed      event.Feed
 	blockProcFeed event.Feed
+	reorgRefFeed  event.Feed
 	scope         event.SubscriptionScope
 	genesisBlock  *types.Block
 
@@ -201,6 +204,7 @@ type BlockChain struct {
 	blockCache    *lru.Cache     // Cache for the most recent entire blocks
 	txLookupCache *lru.Cache     // Cache for the most recent transaction lookup data.
 	futureBlocks  *lru.Cache     // future blocks are blocks added for later processing
+	refusedBlocks *lru.Cache     // Side chain blocks of refused reorgs, with the canonical block deeper than the limit
 
 	wg            sync.WaitGroup //
 	quit          chan struct{}  // shutdown signal, closed in Stop.
@@ -214,6 +218,10 @@ type BlockChain struct {
 	vmConfig   vm.Config
 
 	shouldPreserve func(*types.Block) bool // Function used to determine whether should preserve the given block.
+
+	// maxReorgDepth is the maximum number of blocks a chain reorganisation may
+	// drop on PoSA chains, 0 to use the default of the engine.
+	maxReorgDepth uint64
 }
 
 // NewBlockChain returns a fully initialised block chain using information
@@ -229,6 +237,7 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 	blockCache, _ := lru.New(blockCacheLimit)
 	txLookupCache, _ := lru.New(txLookupCacheLimit)
 	futureBlocks, _ := lru.New(maxFutureBlocks)
+	refusedBlocks, _ := lru.New(maxRefusedBlocks)
 
 	bc := &BlockChain{
 		chainConfig: chainConfig,
@@ -249,6 +258,7 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 		blockCache:     blockCache,
 		txLookupCache:  txLookupCache,
 		futureBlocks:   futureBlocks,
+		refusedBlocks:  refusedBlocks,
 		engine:         engine,
 		vmConfig:       vmConfig,
 	}
@@ -1190,6 +1200,9 @@ func (bc *BlockChain) writeBlockWithoutState(block *types.Block, td *big.Int) (e
 func (bc *BlockChain) writeKnownBlock(block *types.Block) error {
 	current := bc.CurrentBlock()
 	if block.ParentHash() != current.Hash() {
+		if bc.reorgTooDeep(current, block) {
+			return ErrReorgTooDeep
+		}
 		if err := bc.reorg(current, block); err != nil {
 			return err
 		}
@@ -1332,6 +1345,11 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 			reorg = !currentPreserve && (blockPreserve || mrand.Float64() < 0.5)
 		}
 	}
+	// Refuse to drop more blocks than allowed for PoSA chains, as the difficulty
+	// based fork choice would follow any long enough out-of-turn chain
+	if reorg && block.ParentHash() != currentBlock.Hash() && bc.reorgTooDeep(currentBlock, block) {
+		reorg = false
+	}
 	if reorg {
 		// Reorganise the chain if the parent is not the head block
 		if block.ParentHash() != currentBlock.Hash() {
@@ -1877,6 +1895,108 @@ func (bc *BlockChain) insertSideChain(block *types.Block, it *insertIterator) (i
 	return 0, nil
 }
 
+// SetMaxReorgDepth sets the maximum number of blocks a chain reorganisation may
+// drop on PoSA chains, 0 to use the default of the consensus engine.
+func (bc *BlockChain) SetMaxReorgDepth(depth uint64) {
+	atomic.StoreUint64(&bc.maxReorgDepth, depth)
+}
+
+// MaxReorgDepth returns the maximum number of blocks a chain reorganisation from
+// the given head may drop, 0 if unlimited.
+func (bc *BlockChain) MaxReorgDepth(head *types.Header) uint64 {
+	posa, ok := bc.engine.(consensus.PoSA)
+	if !ok {
+		return 0
+	}
+	if depth := atomic.LoadUint64(&bc.maxReorgDepth); depth != 0 {
+		return depth
+	}
+	return posa.MaxReorgDepth(bc, head)
+}
+
+// reorgDepth returns the number of canonical blocks a reorganisation from the old
+// head to the new one drops, along with the last canonical block dropped. The
+// walk stops once the depth exceeds the limit.
+func (bc *BlockChain) reorgDepth(oldHead, newHead *types.Header, limit uint64) (uint64, *types.Header) {
+	for newHead != nil && newHead.Number.Uint64() > oldHead.Number.Uint64() {
+		newHead = bc.GetHeader(newHead.ParentHash, newHead.Number.Uint64()-1)
+	}
+	var (
+		depth   uint64
+		dropped *types.Header
+	)
+	for oldHead != nil && newHead != nil && oldHead.Hash() != newHead.Hash() && depth <= limit {
+		if oldHead.Number.Uint64() == newHead.Number.Uint64() {
+			newHead = bc.GetHeader(newHead.ParentHash, newHead.Number.Uint64()-1)
+		}
+		dropped = oldHead
+		oldHead = bc.GetHeader(oldHead.ParentHash, oldHead.Number.Uint64()-1)
+		depth++
+	}
+	return depth, dropped
+}
+
+// reorgTooDeep checks whether making the given block the new head drops more
+// blocks than allowed, in which case the reorganisation must be refused. A
+// refused reorganisation is reported once per fork: the later blocks of the same
+// side chain are refused without walking the chain again, as long as the
+// canonical block found deeper than the limit stays canonical.
+func (bc *BlockChain) reorgTooDeep(head, block *types.Block) bool {
+	limit := bc.MaxReorgDepth(head.Header())
+	if limit == 0 {
+		return false
+	}
+	if anchor, ok := bc.refusedBlocks.Get(block.ParentHash()); ok {
+		if dropped := anchor.(*types.Header); bc.GetCanonicalHash(dropped.Number.Uint64()) == dropped.Hash() {
+			bc.refusedBlocks.Add(block.Hash(), dropped)
+			log.Debug("Refused chain reorg of a refused fork", "number", block.Number(), "hash", block.Hash())
+			return true
+		}
+	}
+	depth, dropped := bc.reorgDepth(head.Header(), block.Header(), limit)
+	if depth <= limit {
+		return false
+	}
+	bc.refusedBlocks.Add(block.Hash(), dropped)
+
+	blockReorgRefusedMeter.Mark(1)
+	log.Error("Refused too deep chain reorg", "number", head.Number(), "hash", head.Hash(),
+		"newnumber", block.Number(), "newhash", block.Hash(), "depth", depth, "limit", limit)
+	bc.reorgRefFeed.Send(ReorgRefusedEvent{Head: head, Block: block, Depth: depth, Limit: limit})
+	return true
+}
+
+// ForceReorg makes the given block the head of the chain whatever the depth of
+// the reorganisation, overriding a refused one. The block's state must be available.
+func (bc *BlockChain) ForceReorg(hash common.Hash) error {
+	if !bc.chainmu.TryLock() {
+		return errChainStopped
+	}
+	defer bc.chainmu.Unlock()
+
+	block := bc.GetBlockByHash(hash)
+	if block == nil {
+		return fmt.Errorf("unknown block %x", hash)
+	}
+	if bc.GetCanonicalHash(block.NumberU64()) == hash {
+		return fmt.Errorf("block %x is already canonical", hash)
+	}
+	if !bc.HasState(block.Root()) {
+		return fmt.Errorf("missing state of block %x", hash)
+	}
+	current := bc.CurrentBlock()
+	if block.ParentHash() != current.Hash() {
+		if err := bc.reorg(current, block); err != nil {
+			return err
+		}
+	}
+	bc.writeHeadBlock(block)
+	bc.chainHeadFeed.Send(ChainHeadEvent{Block: block})
+
+	log.Warn("Forced chain reorg", "number", current.Number(), "hash", current.Hash(), "newnumber", block.Number(), "newhash", block.Hash())
+	return nil
+}
+
 // reorg takes two blocks, an old chain and a new chain and will reconstruct the
 // blocks and inserts them to be part of the new canonical chain and accumulates
 // potential missing transactions and post an event about them.
@@ -385,3 +385,8 @@ func (bc *BlockChain) SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscript
 func (bc *BlockChain) SubscribeBlockProcessingEvent(ch chan<- bool) event.Subscription {
 	return bc.scope.Track(bc.blockProcFeed.Subscribe(ch))
 }
+
+// SubscribeReorgRefusedEvent registers a subscription of ReorgRefusedEvent.
+func (bc *BlockChain) SubscribeReorgRefusedEvent(ch chan<- ReorgRefusedEvent) event.Subscription {
+	return bc.scope.Track(bc.reorgRefFeed.Subscribe(ch))
+}
@@ -3336,3 +3336,111 @@ func TestEIP1559Transition(t *testing.T) {
 		t.Fatalf("sender balance incorrect: expected %d, got %d", expected, actual)
 	}
 }
+
+// reorgLimitEngine is an ethash faker acting as a PoSA engine without any
+// system contract, to test the PoSA reorg depth limit.
+type reorgLimitEngine struct {
+	consensus.Engine
+	depth uint64
+}
+
+func (e *reorgLimitEngine) PreHandle(consensus.ChainHeaderReader, *types.Header, *state.StateDB) error {
+	return nil
+}
+func (e *reorgLimitEngine) IsSysTransaction(common.Address, *types.Transaction, *types.Header) (bool, error) {
+	return false, nil
+}
+func (e *reorgLimitEngine) CanCreate(consensus.StateReader, common.Address, *big.Int) bool {
+	return true
+}
+func (e *reorgLimitEngine) CanTransferByWhitelist(consensus.StateReader, common.Address, *big.Int) bool {
+	return true
+}
+func (e *reorgLimitEngine) ValidateTx(common.Address, *types.Transaction, *types.Header, *state.StateDB) error {
+	return nil
+}
+func (e *reorgLimitEngine) CreateEvmExtraValidator(*types.Header, *state.StateDB) types.EvmExtraValidator {
+	return nil
+}
+func (e *reorgLimitEngine) ApplySysTx(*vm.EVM, *state.StateDB, int, common.Address, *types.Transaction) ([]byte, error, error) {
+	return nil, nil, nil
+}
+func (e *reorgLimitEngine) TraceTxPolicy(common.Address, *common.Address, *big.Int, []byte, *types.Header, *state.StateDB, types.PolicyHook) error {
+	return nil
+}
+func (e *reorgLimitEngine) TraceFinalizeCalls(consensus.ChainHeaderReader, *types.Header, *state.StateDB, bool, consensus.SysCallTracer) error {
+	return nil
+}
+func (e *reorgLimitEngine) TraceFinishProposals(consensus.ChainHeaderReader, *types.Header, *state.StateDB, []*types.Transaction, consensus.SysCallTracer) error {
+	return nil
+}
+func (e *reorgLimitEngine) MaxReorgDepth(consensus.ChainHeaderReader, *types.Header) uint64 {
+	return e.depth
+}
+
+// Tests that PoSA chains refuse reorgs dropping more blocks than allowed, unless
+// forced by the operator.
+func TestMaxReorgDepth(t *testing.T) {
+	engine := &reorgLimitEngine{Engine: ethash.NewFaker(), depth: 3}
+	db, blockchain, err := newCanonical(engine, 0, true)
+	if err != nil {
+		t.Fatalf("failed to create pristine chain: %v", err)
+	}
+	defer blockchain.Stop()
+
+	refused := make(chan ReorgRefusedEvent, 16)
+	sub := blockchain.SubscribeReorgRefusedEvent(refused)
+	defer sub.Unsubscribe()
+
+	genesis := blockchain.Genesis()
+	canon := makeBlockChain(genesis, 6, engine, db, canonicalSeed)
+	if _, err := blockchain.InsertChain(canon); err != nil {
+		t.Fatalf("failed to insert canonical chain: %v", err)
+	}
+	// A heavier fork dropping the whole canonical chain is refused
+	fork := makeBlockChain(genesis, 10, engine, db, forkSeed)
+	if _, err := blockchain.InsertChain(fork); err != nil {
+		t.Fatalf("failed to insert fork: %v", err)
+	}
+	if head := blockchain.CurrentBlock().Hash(); head != canon[len(canon)-1].Hash() {
+		t.Fatalf("deep reorg accepted: head %x", head)
+	}
+	select {
+	case ev := <-refused:
+		if ev.Head.Hash() != canon[len(canon)-1].Hash() || ev.Depth != 4 || ev.Limit != 3 {
+			t.Fatalf("refused reorg event mismatch: head %d, depth %d, limit %d", ev.Head.NumberU64(), ev.Depth, ev.Limit)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("no refused reorg event")
+	}
+	// The later blocks of the refused fork are refused without another report
+	select {
+	case ev := <-refused:
+		t.Fatalf("refused fork reported again: new head %d", ev.Block.NumberU64())
+	case <-time.After(100 * time.Millisecond):
+	}
+	if err := blockchain.writeKnownBlock(fork[len(fork)-1]); !errors.Is(err, ErrReorgTooDeep) {
+		t.Fatalf("known block of the refused fork: have %v, want %v", err, ErrReorgTooDeep)
+	}
+	if head := blockchain.CurrentBlock().Hash(); head != canon[len(canon)-1].Hash() {
+		t.Fatalf("deep reorg accepted through a known block: head %x", head)
+	}
+	// A shallow reorg is still followed
+	shallow := makeBlockChain(canon[3], 4, engine, db, forkSeed)
+	if _, err := blockchain.InsertChain(shallow); err != nil {
+		t.Fatalf("failed to insert shallow fork: %v", err)
+	}
+	if head := blockchain.CurrentBlock().Hash(); head != shallow[len(shallow)-1].Hash() {
+		t.Fatalf("shallow reorg refused: head %x", head)
+	}
+	// The operator can force the refused reorg
+	if err := blockchain.ForceReorg(fork[len(fork)-1].Hash()); err != nil {
+		t.Fatalf("failed to force reorg: %v", err)
+	}
+	if head := blockchain.CurrentBlock().Hash(); head != fork[len(fork)-1].Hash() {
+		t.Fatalf("forced reorg not applied: head %x", head)
+	}
+	if hash := blockchain.GetCanonicalHash(1); hash != fork[0].Hash() {
+		t.Fatalf("canonical block 1 mismatch: have %x, want %x", hash, fork[0].Hash())
+	}
+}
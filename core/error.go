@@ -32,6 +32,10 @@ var (
 	// ErrNoGenesis is returned when there is no Genesis Block.
 	ErrNoGenesis = errors.New("genesis not found in chain")
 
+	// ErrReorgTooDeep is returned if making a block to import the head would drop
+	// more blocks than allowed.
+	ErrReorgTooDeep = errors.New("chain reorg too deep")
+
 	errSideChainReceipts = errors.New("side blocks can't be accepted as ancient chain data")
 )
 
@@ -24,6 +24,14 @@ import (
 // NewTxsEvent is posted when a batch of transactions enter the transaction pool.
 type NewTxsEvent struct{ Txs []*types.Transaction }
 
+// DropTxsEvent is posted when a batch of transactions is evicted from the
+// transaction pool because it became invalid, e.g. its sender or recipient
+// got blacklisted.
+type DropTxsEvent struct {
+	Txs    []*types.Transaction
+	Reason error
+}
+
 // NewMinedBlockEvent is posted when a block has been imported.
 type NewMinedBlockEvent struct{ Block *types.Block }
 
@@ -41,3 +49,12 @@ type ChainSideEvent struct {
 }
 
 type ChainHeadEvent struct{ Block *types.Block }
+
+// ReorgRefusedEvent is posted when a chain reorganisation dropping more blocks
+// than allowed on PoSA chains is refused.
+type ReorgRefusedEvent struct {
+	Head  *types.Block // Head of the chain kept
+	Block *types.Block // Block refused as the new head
+	Depth uint64       // Number of blocks the reorganisation would drop (walked up to Limit+1)
+	Limit uint64       // Maximum number of blocks a reorganisation may drop
+}
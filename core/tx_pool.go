@@ -106,6 +106,9 @@ var (
 	queuedNofundsMeter   = metrics.NewRegisteredMeter("txpool/queued/nofunds", nil)   // Dropped due to out-of-funds
 	queuedEvictionMeter  = metrics.NewRegisteredMeter("txpool/queued/eviction", nil)  // Dropped due to lifetime
 
+	// policyEvictionMeter counts the transactions evicted because of a policy change
+	policyEvictionMeter = metrics.NewRegisteredMeter("txpool/policy/eviction", nil)
+
 	// General tx metrics
 	knownTxMeter       = metrics.NewRegisteredMeter("txpool/known", nil)
 	validTxMeter       = metrics.NewRegisteredMeter("txpool/valid", nil)
@@ -153,6 +156,16 @@ type exTxValidator interface {
 	ValidateTx(sender common.Address, tx *types.Transaction, header *types.Header, parentState *state.StateDB) error
 }
 
+// exTxPolicyValidator is an exTxValidator which can tell when its policy (e.g.
+// the blacklist) was last changed, so the pool can evict the transactions the
+// change invalidated instead of leaving them to the miner.
+type exTxPolicyValidator interface {
+	exTxValidator
+	// PolicyUpdatedNumber returns the number of the block which changed the
+	// policy last, at the given state.
+	PolicyUpdatedNumber(state *state.StateDB) uint64
+}
+
 // TxPoolConfig are the configuration parameters of the transaction pool.
 type TxPoolConfig struct {
 	Locals    []common.Address // Addresses that should be treated by default as local
@@ -245,6 +258,7 @@ type TxPool struct {
 	chain       blockChain
 	gasPrice    *big.Int
 	txFeed      event.Feed
+	dropFeed    event.Feed
 	scope       event.SubscriptionScope
 	signer      types.Signer
 	mu          sync.RWMutex
@@ -275,6 +289,8 @@ type TxPool struct {
 	// during a large chain insertion, the ChainHeadEvent will not be fired in time, then some old trie-nodes
 	// will be discarded due to GC, and it will cause failure to get blacklist.
 	disableExValidate bool
+	policyUpdated     uint64 // Block number of the last policy change seen by the pool
+	policyChanged     bool   // Whether the policy changed since the last reorg run
 
 	chainHeadCh     chan ChainHeadEvent
 	chainHeadSub    event.Subscription
@@ -353,8 +369,14 @@ func NewTxPool(config TxPoolConfig, chainconfig *params.ChainConfig, chain block
 
 // InitExTxValidator sets the extra validator
 func (pool *TxPool) InitExTxValidator(v exTxValidator) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
 	pool.makeFakeHeader(pool.chain.CurrentBlock().Header())
 	pool.txValidator = v
+	if pv, ok := v.(exTxPolicyValidator); ok && pool.currentState != nil {
+		pool.policyUpdated = pv.PolicyUpdatedNumber(pool.currentState)
+	}
 }
 
 // loop is the transaction pool's main event loop, waiting for and reacting to
@@ -460,6 +482,12 @@ func (pool *TxPool) SubscribeNewTxsEvent(ch chan<- NewTxsEvent) event.Subscripti
 	return pool.scope.Track(pool.txFeed.Subscribe(ch))
 }
 
+// SubscribeDropTxsEvent registers a subscription of DropTxsEvent and
+// starts sending event to the given channel.
+func (pool *TxPool) SubscribeDropTxsEvent(ch chan<- DropTxsEvent) event.Subscription {
+	return pool.scope.Track(pool.dropFeed.Subscribe(ch))
+}
+
 // GasPrice returns the current gas price enforced by the transaction pool.
 func (pool *TxPool) GasPrice() *big.Int {
 	pool.mu.RLock()
@@ -1212,11 +1240,21 @@ func (pool *TxPool) runReorg(done chan struct{}, reset *txpoolResetRequest, dirt
 		// the flatten operation can be avoided.
 		promoteAddrs = dirtyAccounts.flatten()
 	}
+	var dropped map[error][]*types.Transaction
 	pool.mu.Lock()
 	if reset != nil {
 		// Reset from the old head to the new, rescheduling any reorged transactions
 		pool.reset(reset.oldHead, reset.newHead)
 
+		// Evict everything the new policy denies before promoting anything,
+		// retrying on the next reset until a sweep validated every transaction
+		if pool.policyChanged {
+			var complete bool
+			if dropped, complete = pool.evictDenied(); complete {
+				pool.policyChanged = false
+			}
+		}
+
 		// Nonces were reset, discard any events that became stale
 		for addr := range events {
 			events[addr].Forward(pool.pendingNonces.get(addr))
@@ -1258,6 +1296,10 @@ func (pool *TxPool) runReorg(done chan struct{}, reset *txpoolResetRequest, dirt
 	pool.changesSinceReorg = 0 // Reset change counter
 	pool.mu.Unlock()
 
+	// Notify subsystems for evicted transactions
+	for reason, txs := range dropped {
+		pool.dropFeed.Send(DropTxsEvent{Txs: txs, Reason: reason})
+	}
 	// Notify subsystems for newly added transactions
 	for _, tx := range promoted {
 		addr, _ := types.Sender(pool.signer, tx)
@@ -1358,6 +1400,12 @@ func (pool *TxPool) reset(oldHead, newHead *types.Header) {
 	if pool.txValidator != nil {
 		pool.makeFakeHeader(newHead)
 		pool.disableExValidate = false
+
+		if pv, ok := pool.txValidator.(exTxPolicyValidator); ok {
+			if number := pv.PolicyUpdatedNumber(statedb); number != pool.policyUpdated {
+				pool.policyUpdated, pool.policyChanged = number, true
+			}
+		}
 	}
 
 	// Inject any transactions discarded due to reorgs
@@ -1383,6 +1431,52 @@ func (pool *TxPool) makeFakeHeader(currHead *types.Header) {
 	}
 }
 
+// evictDenied re-validates every pending and queued transaction against the
+// extra validator, removing the ones it denies now. Removing a pending
+// transaction demotes the subsequent ones of the same account. The evicted
+// transactions are returned grouped by the reason of the eviction, along with
+// whether every transaction could be validated. Transactions failing for any
+// other reason are kept.
+func (pool *TxPool) evictDenied() (map[error][]*types.Transaction, bool) {
+	if pool.txValidator == nil || pool.disableExValidate {
+		return nil, false
+	}
+	var (
+		denied   []*types.Transaction
+		dropped  = make(map[error][]*types.Transaction)
+		complete = true
+	)
+	check := func(lists map[common.Address]*txList) {
+		for addr, list := range lists {
+			for _, tx := range list.Flatten() {
+				err := pool.txValidator.ValidateTx(addr, tx, pool.nextFakeHeader, pool.currentState)
+				if err == nil {
+					continue
+				}
+				if !errors.Is(err, types.ErrAddressDenied) && !errors.Is(err, types.ErrUnauthorizedCreateTx) && !errors.Is(err, types.ErrUnauthorizedTransferTx) {
+					log.Info("ValidateTx error", "hash", tx.Hash(), "err", err)
+					complete = false
+					continue
+				}
+				denied = append(denied, tx)
+				dropped[err] = append(dropped[err], tx)
+			}
+		}
+	}
+	check(pool.pending)
+	check(pool.queue)
+
+	for _, tx := range denied {
+		log.Trace("Evicting denied transaction", "hash", tx.Hash())
+		pool.removeTx(tx.Hash(), true)
+	}
+	if len(denied) > 0 {
+		log.Debug("Evicted transactions denied by policy change", "count", len(denied), "policyUpdated", pool.policyUpdated)
+		policyEvictionMeter.Mark(int64(len(denied)))
+	}
+	return dropped, complete
+}
+
 // promoteExecutables moves transactions that have become processable from the
 // future queue to the set of pending transactions. During this process, all
 // invalidated transactions (low nonce, low balance) are deleted.
@@ -813,6 +813,128 @@ func TestTransactionGapFilling(t *testing.T) {
 	}
 }
 
+// testPolicyValidator is a policy aware extra validator denying the blacklisted
+// senders.
+type testPolicyValidator struct {
+	blacks  map[common.Address]bool
+	broken  map[common.Address]bool
+	updated uint64
+}
+
+func (v *testPolicyValidator) ValidateTx(sender common.Address, tx *types.Transaction, header *types.Header, parentState *state.StateDB) error {
+	if v.broken[sender] {
+		return errors.New("validation failed")
+	}
+	if v.blacks[sender] {
+		return types.ErrAddressDenied
+	}
+	return nil
+}
+
+func (v *testPolicyValidator) PolicyUpdatedNumber(state *state.StateDB) uint64 {
+	return v.updated
+}
+
+// Tests that a blacklist change evicts the pending and queued transactions of
+// the blacklisted accounts on the next reset, and reports them as dropped.
+func TestTransactionPolicyEviction(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	other, _ := crypto.GenerateKey()
+	black, clean := crypto.PubkeyToAddress(key.PublicKey), crypto.PubkeyToAddress(other.PublicKey)
+	testAddBalance(pool, black, big.NewInt(1000000000))
+	testAddBalance(pool, clean, big.NewInt(1000000000))
+
+	validator := &testPolicyValidator{blacks: make(map[common.Address]bool)}
+	pool.InitExTxValidator(validator)
+
+	pool.AddRemotesSync([]*types.Transaction{
+		transaction(0, 100000, key), transaction(1, 100000, key), transaction(3, 100000, key),
+		transaction(0, 100000, other),
+	})
+	if pending, queued := pool.Stats(); pending != 3 || queued != 1 {
+		t.Fatalf("pool stats mismatch: have %d/%d, want 3/1", pending, queued)
+	}
+	drops := make(chan DropTxsEvent, 1)
+	sub := pool.SubscribeDropTxsEvent(drops)
+	defer sub.Unsubscribe()
+
+	// Resetting without a policy change must keep everything
+	validator.blacks[black] = true
+	<-pool.requestReset(nil, nil)
+	if pending, queued := pool.Stats(); pending != 3 || queued != 1 {
+		t.Fatalf("pool stats mismatch without policy change: have %d/%d, want 3/1", pending, queued)
+	}
+	// Announcing the change must evict all the transactions of the blacklisted account
+	validator.updated = 1
+	<-pool.requestReset(nil, nil)
+	if pending, queued := pool.Stats(); pending != 1 || queued != 0 {
+		t.Fatalf("pool stats mismatch after policy change: have %d/%d, want 1/0", pending, queued)
+	}
+	select {
+	case ev := <-drops:
+		if len(ev.Txs) != 3 || !errors.Is(ev.Reason, types.ErrAddressDenied) {
+			t.Fatalf("drop event mismatch: have %d txs with reason %v, want 3 with %v", len(ev.Txs), ev.Reason, types.ErrAddressDenied)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("drop event not fired")
+	}
+	if err := validateTxPoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
+// Tests that a transaction failing the validation for another reason than the
+// policy doesn't stop the eviction of the denied ones, and that the sweep is
+// retried until every transaction is validated.
+func TestTransactionPolicyEvictionFailure(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupTxPool()
+	defer pool.Stop()
+
+	other, _ := crypto.GenerateKey()
+	black, broken := crypto.PubkeyToAddress(key.PublicKey), crypto.PubkeyToAddress(other.PublicKey)
+	testAddBalance(pool, black, big.NewInt(1000000000))
+	testAddBalance(pool, broken, big.NewInt(1000000000))
+
+	validator := &testPolicyValidator{blacks: make(map[common.Address]bool), broken: make(map[common.Address]bool)}
+	pool.InitExTxValidator(validator)
+
+	pool.AddRemotesSync([]*types.Transaction{transaction(0, 100000, key), transaction(0, 100000, other)})
+	if pending, _ := pool.Stats(); pending != 2 {
+		t.Fatalf("pending transactions mismatch: have %d, want 2", pending)
+	}
+	// The denied transaction is evicted despite the failing one, which is kept
+	validator.blacks[black], validator.broken[broken] = true, true
+	validator.updated = 1
+	<-pool.requestReset(nil, nil)
+	if pending, _ := pool.Stats(); pending != 1 || pool.Get(transaction(0, 100000, other).Hash()) == nil {
+		t.Fatalf("pending transactions mismatch after a failed sweep: have %d, want the failing one", pending)
+	}
+	pool.mu.RLock()
+	changed := pool.policyChanged
+	pool.mu.RUnlock()
+	if !changed {
+		t.Fatal("policy change cleared by an incomplete sweep")
+	}
+	// The next reset retries the sweep, completing it
+	validator.broken[broken], validator.blacks[broken] = false, true
+	<-pool.requestReset(nil, nil)
+	if pending, _ := pool.Stats(); pending != 0 {
+		t.Fatalf("pending transactions mismatch after the retried sweep: have %d, want 0", pending)
+	}
+	pool.mu.RLock()
+	changed = pool.policyChanged
+	pool.mu.RUnlock()
+	if changed {
+		t.Fatal("policy change kept after a complete sweep")
+	}
+}
+
 // Tests that if the transaction count belonging to a single account goes above
 // some threshold, the higher transactions are dropped to prevent DOS attacks.
 func TestTransactionQueueAccountLimiting(t *testing.T) {
@@ -19,3 +19,55 @@ type EvmExtraValidator interface {
 	//
 	// IsBEndAuthorized(common.Address) bool
 }
+
+// PolicyCheckKind tells which policy a PolicyCheck was evaluated against.
+type PolicyCheckKind int
+
+const (
+	PolicyBlacklist         PolicyCheckKind = iota // address blacklist on a call or transaction
+	PolicyEventRule                                // address blacklist on a log, through an event check rule
+	PolicyTransferWhitelist                        // developer whitelist for value transfers
+	PolicyCreateWhitelist                          // developer whitelist for contract creations
+)
+
+// String implements fmt.Stringer.
+func (k PolicyCheckKind) String() string {
+	switch k {
+	case PolicyBlacklist:
+		return "blacklist"
+	case PolicyEventRule:
+		return "eventRule"
+	case PolicyTransferWhitelist:
+		return "transferWhitelist"
+	case PolicyCreateWhitelist:
+		return "createWhitelist"
+	default:
+		return "unknown"
+	}
+}
+
+// PolicyCheck describes a single policy evaluation, it's reported to a PolicyHook
+// for tracing purpose.
+type PolicyCheck struct {
+	Kind      PolicyCheckKind
+	Address   common.Address
+	CheckType common.AddressCheckType
+	Denied    bool
+
+	// The fields below are only set for PolicyEventRule checks.
+	EventSig   common.Hash            // Signature of the event which matched the rule
+	Location   common.AddressLocation // Part of the log the address was taken from
+	TopicIndex int                    // Index of the topic or data word the address was taken from
+	ArrayIndex int                    // Index of the address in its array, only for LocationDataArray
+}
+
+// PolicyHook is invoked for every policy check made by a traceable validator.
+type PolicyHook func(check *PolicyCheck)
+
+// TraceableEvmExtraValidator is an EvmExtraValidator which is able to report
+// every check it makes.
+type TraceableEvmExtraValidator interface {
+	EvmExtraValidator
+	// WithHook returns a copy of the validator reporting all checks to hook.
+	WithHook(hook PolicyHook) EvmExtraValidator
+}
@@ -144,6 +144,13 @@ func NewEVM(blockCtx BlockContext, txCtx TxContext, statedb StateDB, chainConfig
 		chainConfig: chainConfig,
 		chainRules:  chainConfig.Rules(blockCtx.BlockNumber),
 	}
+	if config.Debug {
+		if logger, ok := config.Tracer.(PolicyLogger); ok {
+			if validator, ok := blockCtx.ExtraValidator.(types.TraceableEvmExtraValidator); ok {
+				evm.Context.ExtraValidator = validator.WithHook(logger.CapturePolicyCheck)
+			}
+		}
+	}
 	evm.interpreter = NewEVMInterpreter(evm, config)
 	return evm
 }
@@ -112,6 +112,13 @@ type EVMLogger interface {
 	CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error)
 }
 
+// PolicyLogger is an optional extension of EVMLogger. If the tracer implements
+// it, and the block context carries a traceable ExtraValidator, every policy
+// check made during the execution is reported to CapturePolicyCheck.
+type PolicyLogger interface {
+	CapturePolicyCheck(check *types.PolicyCheck)
+}
+
 // StructLogger is an EVM state logger and implements EVMLogger.
 //
 // StructLogger can capture state based on the given Log configuration and also keeps
@@ -0,0 +1,76 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/congress"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p"
+)
+
+// startNodeAllowlistUpdater starts the node allowlist updater loop, which listens
+// for chain head events and restricts the peers of the server to the nodes of the
+// on-chain allowlist whenever it changes.
+//
+// If the allowlist can't be read at a head (e.g. its state is missing), the last
+// allowlist read is kept, which allows every node if none was read yet. If
+// failClosed is set, only the trusted peers are kept instead, until the allowlist
+// can be read again.
+func startNodeAllowlistUpdater(chain *core.BlockChain, engine *congress.Congress, srv *p2p.Server, failClosed bool) {
+	var (
+		newHead = make(chan core.ChainHeadEvent, 10)
+		sub     = chain.SubscribeChainHeadEvent(newHead)
+		current *p2p.NodeAllowlist
+	)
+	update := func(head *types.Header) {
+		var list *p2p.NodeAllowlist
+		ids, nets, err := engine.NodeAllowlist(head)
+		switch {
+		case err == nil:
+			list = p2p.NewNodeAllowlist(ids, nets)
+		case failClosed:
+			log.Warn("Failed to read node allowlist, keeping trusted peers only", "number", head.Number, "err", err)
+			list = p2p.NewClosedNodeAllowlist()
+		default:
+			log.Warn("Failed to read node allowlist, keeping the last one", "number", head.Number, "err", err)
+			return
+		}
+		if current != nil && current.Equal(list) {
+			return
+		}
+		if err == nil {
+			log.Info("Updated node allowlist", "number", head.Number, "nodes", len(ids), "nets", len(nets))
+		}
+		srv.SetNodeAllowlist(list)
+		current = list
+	}
+	update(chain.CurrentHeader())
+
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case ev := <-newHead:
+				update(ev.Block.Header())
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+}
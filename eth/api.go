@@ -257,6 +257,79 @@ func (api *PrivateAdminAPI) ImportChain(file string) (bool, error) {
 	return true, nil
 }
 
+// MaxReorgDepth returns the maximum number of blocks a chain reorganisation from
+// the current head may drop, 0 if unlimited.
+func (api *PrivateAdminAPI) MaxReorgDepth() uint64 {
+	chain := api.eth.BlockChain()
+	return chain.MaxReorgDepth(chain.CurrentHeader())
+}
+
+// SetMaxReorgDepth sets the maximum number of blocks a chain reorganisation may
+// drop on PoSA chains, 0 to use the default of the consensus engine.
+func (api *PrivateAdminAPI) SetMaxReorgDepth(depth uint64) bool {
+	api.eth.BlockChain().SetMaxReorgDepth(depth)
+	return true
+}
+
+// ForceReorg makes the given block the head of the chain, overriding the refusal
+// of a too deep chain reorganisation.
+func (api *PrivateAdminAPI) ForceReorg(hash common.Hash) (bool, error) {
+	if err := api.eth.BlockChain().ForceReorg(hash); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// refusedReorg is a chain reorganisation refused for its depth.
+type refusedReorg struct {
+	Number    hexutil.Uint64 `json:"number"`
+	Hash      common.Hash    `json:"hash"`
+	NewNumber hexutil.Uint64 `json:"newNumber"`
+	NewHash   common.Hash    `json:"newHash"`
+	Depth     hexutil.Uint64 `json:"depth"`
+	Limit     hexutil.Uint64 `json:"limit"`
+}
+
+// RefusedReorgs creates a subscription fired for every chain reorganisation
+// refused for dropping more blocks than allowed.
+func (api *PrivateAdminAPI) RefusedReorgs(ctx context.Context) (*rpc.Subscription, error) {
+	refused := make(chan core.ReorgRefusedEvent, 8)
+	sub := api.eth.BlockChain().SubscribeReorgRefusedEvent(refused)
+	return rpc.NotifyEvents(ctx, sub, refused, func(event interface{}) interface{} {
+		ev := event.(core.ReorgRefusedEvent)
+		return &refusedReorg{
+			Number:    hexutil.Uint64(ev.Head.NumberU64()),
+			Hash:      ev.Head.Hash(),
+			NewNumber: hexutil.Uint64(ev.Block.NumberU64()),
+			NewHash:   ev.Block.Hash(),
+			Depth:     hexutil.Uint64(ev.Depth),
+			Limit:     hexutil.Uint64(ev.Limit),
+		}
+	})
+}
+
+// droppedTxs is a batch of transactions evicted from the transaction pool.
+type droppedTxs struct {
+	Hashes []common.Hash `json:"hashes"`
+	Reason string        `json:"reason"`
+}
+
+// DroppedTransactions creates a subscription fired for every batch of
+// transactions evicted from the transaction pool, e.g. after a policy change
+// denying their sender or recipient.
+func (api *PrivateAdminAPI) DroppedTransactions(ctx context.Context) (*rpc.Subscription, error) {
+	dropped := make(chan core.DropTxsEvent, 8)
+	sub := api.eth.TxPool().SubscribeDropTxsEvent(dropped)
+	return rpc.NotifyEvents(ctx, sub, dropped, func(event interface{}) interface{} {
+		ev := event.(core.DropTxsEvent)
+		hashes := make([]common.Hash, len(ev.Txs))
+		for i, tx := range ev.Txs {
+			hashes[i] = tx.Hash()
+		}
+		return &droppedTxs{Hashes: hashes, Reason: ev.Reason.Error()}
+	})
+}
+
 // PublicDebugAPI is the collection of Ethereum full node APIs exposed
 // over the public debugging endpoint.
 type PublicDebugAPI struct {
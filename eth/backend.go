@@ -72,6 +72,7 @@ type Ethereum struct {
 	handler            *handler
 	ethDialCandidates  enode.Iterator
 	snapDialCandidates enode.Iterator
+	validators         *validatorNodes
 
 	// DB interfaces
 	chainDb ethdb.Database // Block chain database
@@ -197,6 +198,8 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if err != nil {
 		return nil, err
 	}
+	eth.blockchain.SetMaxReorgDepth(config.MaxReorgDepth)
+
 	// Rewind the chain in case of an incompatible config upgrade.
 	if compat, ok := genesisErr.(*params.ConfigCompatError); ok {
 		log.Warn("Rewinding chain to upgrade configuration", "err", compat)
@@ -218,6 +221,14 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		eth.txPool.InitExTxValidator(congressEngine)
 		//
 		congressEngine.SetChain(eth.blockchain)
+		// record the history of blacklist and whitelist changes, the fee distribution of blocks,
+		// report the validator set changes and count the canonical blocks in the metrics
+		congressEngine.StartPolicyIndexer(eth.blockchain)
+		congressEngine.StartRewardLedger(eth.blockchain)
+		congressEngine.StartValidatorSetFeed(eth.blockchain)
+		congressEngine.StartBlockMetrics(eth.blockchain)
+		// track the nodes run by the validators to relay to them first
+		eth.validators = newValidatorNodes(congressEngine, eth.blockchain)
 	}
 
 	// Permit the downloader to use the trie cache allowance during fast sync
@@ -236,6 +247,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		EventMux:   eth.eventMux,
 		Checkpoint: checkpoint,
 		Whitelist:  config.Whitelist,
+		Validators: eth.validators,
 	}); err != nil {
 		return nil, err
 	}
@@ -259,6 +271,13 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if err != nil {
 		return nil, err
 	}
+	if eth.validators != nil {
+		// Dial the discovered validator nodes along the DNS discovered ones
+		mix := enode.NewFairMix(0)
+		mix.AddSource(eth.validators.candidates)
+		mix.AddSource(eth.ethDialCandidates)
+		eth.ethDialCandidates = mix
+	}
 	eth.snapDialCandidates, err = dnsclient.NewIterator(eth.config.SnapDiscoveryURLs...)
 	if err != nil {
 		return nil, err
@@ -596,6 +615,20 @@ func (s *Ethereum) Protocols() []p2p.Protocol {
 // Ethereum protocol implementation.
 func (s *Ethereum) Start() error {
 	eth.StartENRUpdater(s.blockchain, s.p2pServer.LocalNode())
+	if s.config.NodeAllowlist {
+		engine, ok := s.engine.(*congress.Congress)
+		if !ok {
+			return errors.New("node allowlist requires the congress engine")
+		}
+		startNodeAllowlistUpdater(s.blockchain, engine, s.p2pServer, s.config.NodeAllowlistFailClosed)
+	}
+	if engine, ok := s.engine.(*congress.Congress); ok {
+		// Advertise the local node as run by the validator once it's authorized
+		engine.SetLocalNode(s.p2pServer.LocalNode())
+	}
+	if s.validators != nil {
+		s.validators.start(s.p2pServer)
+	}
 
 	// Start the bloom bits servicing goroutines
 	s.startBloomHandlers(params.BloomBitsBlocks)
@@ -619,6 +652,9 @@ func (s *Ethereum) Stop() error {
 	// Stop all the peer-related stuff first.
 	s.ethDialCandidates.Close()
 	s.snapDialCandidates.Close()
+	if s.validators != nil {
+		s.validators.stop()
+	}
 	s.handler.Stop()
 
 	// Then stop everything else.
@@ -155,6 +155,13 @@ type Config struct {
 	NoPrefetch bool // Whether to disable prefetching and only load state on demand
 
 	TxLookupLimit uint64 `toml:",omitempty"` // The maximum number of blocks from head whose tx indices are reserved.
+	MaxReorgDepth uint64 `toml:",omitempty"` // The maximum number of blocks a chain reorg may drop on PoSA chains, 0 for the engine default.
+
+	// Whether to restrict peering to the nodes of the on-chain node allowlist, and
+	// whether to keep only the trusted peers while the allowlist can't be read
+	// rather than the last allowlist read
+	NodeAllowlist           bool `toml:",omitempty"`
+	NodeAllowlistFailClosed bool `toml:",omitempty"`
 
 	// Whitelist of required block number -> hash values to accept
 	Whitelist map[uint64]common.Hash `toml:"-"`
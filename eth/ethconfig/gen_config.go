@@ -26,6 +26,9 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		NoPruning               bool
 		NoPrefetch              bool
 		TxLookupLimit           uint64                 `toml:",omitempty"`
+		MaxReorgDepth           uint64                 `toml:",omitempty"`
+		NodeAllowlist           bool                   `toml:",omitempty"`
+		NodeAllowlistFailClosed bool                   `toml:",omitempty"`
 		Whitelist               map[uint64]common.Hash `toml:"-"`
 		LightServ               int                    `toml:",omitempty"`
 		LightIngress            int                    `toml:",omitempty"`
@@ -70,6 +73,9 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.NoPruning = c.NoPruning
 	enc.NoPrefetch = c.NoPrefetch
 	enc.TxLookupLimit = c.TxLookupLimit
+	enc.MaxReorgDepth = c.MaxReorgDepth
+	enc.NodeAllowlist = c.NodeAllowlist
+	enc.NodeAllowlistFailClosed = c.NodeAllowlistFailClosed
 	enc.Whitelist = c.Whitelist
 	enc.LightServ = c.LightServ
 	enc.LightIngress = c.LightIngress
@@ -118,6 +124,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		NoPruning               *bool
 		NoPrefetch              *bool
 		TxLookupLimit           *uint64                `toml:",omitempty"`
+		MaxReorgDepth           *uint64                `toml:",omitempty"`
+		NodeAllowlist           *bool                  `toml:",omitempty"`
+		NodeAllowlistFailClosed *bool                  `toml:",omitempty"`
 		Whitelist               map[uint64]common.Hash `toml:"-"`
 		LightServ               *int                   `toml:",omitempty"`
 		LightIngress            *int                   `toml:",omitempty"`
@@ -181,6 +190,15 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.TxLookupLimit != nil {
 		c.TxLookupLimit = *dec.TxLookupLimit
 	}
+	if dec.MaxReorgDepth != nil {
+		c.MaxReorgDepth = *dec.MaxReorgDepth
+	}
+	if dec.NodeAllowlist != nil {
+		c.NodeAllowlist = *dec.NodeAllowlist
+	}
+	if dec.NodeAllowlistFailClosed != nil {
+		c.NodeAllowlistFailClosed = *dec.NodeAllowlistFailClosed
+	}
 	if dec.Whitelist != nil {
 		c.Whitelist = dec.Whitelist
 	}
@@ -85,6 +85,7 @@ type handlerConfig struct {
 	EventMux   *event.TypeMux            // Legacy event mux, deprecate for `feed`
 	Checkpoint *params.TrustedCheckpoint // Hard coded checkpoint for sync challenges
 	Whitelist  map[uint64]common.Hash    // Hard coded whitelist for sync challenged
+	Validators *validatorNodes           // Nodes run by the validators to relay to first (congress only)
 }
 
 type handler struct {
@@ -114,7 +115,8 @@ type handler struct {
 	txsSub        event.Subscription
 	minedBlockSub *event.TypeMuxSubscription
 
-	whitelist map[uint64]common.Hash
+	whitelist  map[uint64]common.Hash
+	validators *validatorNodes
 
 	// channels for fetcher, syncer, txsyncLoop
 	quitSync chan struct{}
@@ -139,6 +141,7 @@ func newHandler(config *handlerConfig) (*handler, error) {
 		chain:      config.Chain,
 		peers:      newPeerSet(),
 		whitelist:  config.Whitelist,
+		validators: config.Validators,
 		quitSync:   make(chan struct{}),
 	}
 	if config.Sync == downloader.FullSync {
@@ -445,8 +448,12 @@ func (h *handler) BroadcastBlock(block *types.Block, propagate bool) {
 			log.Error("Propagating dangling block", "number", block.Number(), "hash", hash)
 			return
 		}
-		// Send the block to a subset of our peers
-		transfer := peers[:int(math.Sqrt(float64(len(peers))))]
+		// Send the block to the validators and a subset of our peers
+		n := h.validatorsFirst(peers)
+		if sqrt := int(math.Sqrt(float64(len(peers)))); n < sqrt {
+			n = sqrt
+		}
+		transfer := peers[:n]
 		for _, peer := range transfer {
 			log.Info("metric", "method", "broadcastBlock", "peer", peer.ID(), "hash", block.Header().Hash().String(), "number", block.Header().Number.Uint64(), "fullBlock", true)
 			peer.AsyncSendNewBlock(block, td)
@@ -482,8 +489,11 @@ func (h *handler) BroadcastTransactions(txs types.Transactions) {
 	// Broadcast transactions to a batch of peers not knowing about it
 	for _, tx := range txs {
 		peers := h.peers.peersWithoutTransaction(tx.Hash())
-		// Send the tx unconditionally to a subset of our peers
-		numDirect := int(math.Sqrt(float64(len(peers))))
+		// Send the tx unconditionally to the validators and a subset of our peers
+		numDirect := h.validatorsFirst(peers)
+		if n := int(math.Sqrt(float64(len(peers)))); numDirect < n {
+			numDirect = n
+		}
 		for _, peer := range peers[:numDirect] {
 			txset[peer] = append(txset[peer], tx.Hash())
 		}
@@ -507,6 +517,22 @@ func (h *handler) BroadcastTransactions(txs types.Transactions) {
 		"tx packs", directPeers, "broadcast txs", directCount)
 }
 
+// validatorsFirst moves the peers run by the current validators to the front of
+// the given list, returning their count.
+func (h *handler) validatorsFirst(peers []*ethPeer) int {
+	if h.validators == nil {
+		return 0
+	}
+	n := 0
+	for i, peer := range peers {
+		if h.validators.contains(peer.Node().ID()) {
+			peers[n], peers[i] = peers[i], peers[n]
+			n++
+		}
+	}
+	return n
+}
+
 // minedBroadcastLoop sends mined blocks to connected peers.
 func (h *handler) minedBroadcastLoop() {
 	defer h.wg.Done()
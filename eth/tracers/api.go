@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"os"
 	"runtime"
 	"sync"
@@ -195,8 +196,36 @@ type StdTraceConfig struct {
 
 // txTraceResult is the result of a single transaction trace.
 type txTraceResult struct {
-	Result interface{} `json:"result,omitempty"` // Trace results produced by the tracer
-	Error  string      `json:"error,omitempty"`  // Trace failure produced by the tracer
+	SysCall string      `json:"systemCall,omitempty"` // Method of the engine-internal system call, if not a transaction
+	Result  interface{} `json:"result,omitempty"`     // Trace results produced by the tracer
+	Error   string      `json:"error,omitempty"`      // Trace failure produced by the tracer
+}
+
+// txPolicyEnv is the environment the transaction-level policy checks of a traced
+// transaction are replayed in: the header of the block the transaction is checked
+// for, and the state that block started from. Like the block processing, the
+// checks read the parent state rather than the one left by the preceding
+// transactions (the blacklist is even cached by the parent hash).
+type txPolicyEnv struct {
+	header *types.Header
+	state  func() (*state.StateDB, error) // Returns a private copy of the state to check against
+}
+
+// newTxPolicyEnv returns the policy environment of a block the given state is
+// the starting state of, the state is copied so it can be advanced further.
+func newTxPolicyEnv(header *types.Header, statedb *state.StateDB) *txPolicyEnv {
+	var (
+		lock  sync.Mutex
+		start = statedb.Copy()
+	)
+	return &txPolicyEnv{
+		header: header,
+		state: func() (*state.StateDB, error) {
+			lock.Lock()
+			defer lock.Unlock()
+			return start.Copy(), nil
+		},
+	}
 }
 
 // blockTraceTask represents a single block trace task when an entire chain is
@@ -279,9 +308,11 @@ func (api *API) traceChain(ctx context.Context, start, end *types.Block, config
 				signer := types.MakeSigner(api.backend.ChainConfig(), task.block.Number())
 				header := task.block.Header()
 				blockCtx := core.NewEVMBlockContext(header, api.chainContext(localctx), nil)
+				var policy *txPolicyEnv
 				if api.isPoSA {
 					_ = api.posa.PreHandle(api.backend.ChainHeaderReader(), header, task.statedb)
 					blockCtx.ExtraValidator = api.posa.CreateEvmExtraValidator(header, task.statedb)
+					policy = newTxPolicyEnv(header, task.statedb)
 				}
 				// Trace all the transactions contained within
 				for i, tx := range task.block.Transactions() {
@@ -302,7 +333,7 @@ func (api *API) traceChain(ctx context.Context, start, end *types.Block, config
 					if isSysTx {
 						res, err = api.tracePoSASysTx(ctx, msg.From(), tx, txctx, blockCtx, task.statedb, config)
 					} else {
-						res, err = api.traceTx(ctx, msg, txctx, blockCtx, task.statedb, config)
+						res, err = api.traceTx(ctx, msg, txctx, blockCtx, task.statedb, policy, config)
 					}
 					if err != nil {
 						task.results[i] = &txTraceResult{Error: err.Error()}
@@ -617,9 +648,11 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 		threads = len(txs)
 	}
 	blockCtx := core.NewEVMBlockContext(block.Header(), api.chainContext(ctx), nil)
+	var policy *txPolicyEnv
 	if api.isPoSA {
 		_ = api.posa.PreHandle(api.backend.ChainHeaderReader(), header, statedb)
 		blockCtx.ExtraValidator = api.posa.CreateEvmExtraValidator(header, statedb)
+		policy = newTxPolicyEnv(header, statedb)
 	}
 	blockHash := block.Hash()
 	for th := 0; th < threads; th++ {
@@ -640,7 +673,7 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 					tx := txs[task.index]
 					res, err = api.tracePoSASysTx(ctx, msg.From(), tx, txctx, blockCtx, task.statedb, config)
 				} else {
-					res, err = api.traceTx(ctx, msg, txctx, blockCtx, task.statedb, config)
+					res, err = api.traceTx(ctx, msg, txctx, blockCtx, task.statedb, policy, config)
 				}
 				if err != nil {
 					results[task.index] = &txTraceResult{Error: err.Error()}
@@ -651,13 +684,32 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 		}()
 	}
 	// Feed the transactions into the tracers and return
-	var failed error
+	var (
+		failed   error
+		firstSys = len(txs) // index of the first system-transaction
+		sysTxs   []*types.Transaction
+		sysCalls = &sysCallTracer{api: api, ctx: ctx, config: config, txctx: &Context{BlockHash: blockHash}}
+		preCalls []*txTraceResult
+	)
 	for i, tx := range txs {
 		var isSysTx bool
 		if api.isPoSA {
 			sender, _ := types.Sender(signer, tx)
 			isSysTx, _ = api.posa.IsSysTransaction(sender, tx, header)
 		}
+		// The engine makes its own system calls between the ordinary and the
+		// system-transactions, trace them in between.
+		if isSysTx && sysTxs == nil {
+			firstSys = i
+			if preCalls, failed = sysCalls.trace(func() error {
+				return api.posa.TraceFinalizeCalls(api.backend.ChainHeaderReader(), header, statedb, i > 0, sysCalls)
+			}); failed != nil {
+				break
+			}
+		}
+		if isSysTx {
+			sysTxs = append(sysTxs, tx)
+		}
 		// Send the trace task over for execution
 		jobs <- &txTraceTask{statedb: statedb.Copy(), index: i, isSysTx: isSysTx}
 
@@ -681,6 +733,19 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 		// Only delete empty objects if EIP158/161 (a.k.a Spurious Dragon) is in effect
 		statedb.Finalise(vmenv.ChainConfig().IsEIP158(block.Number()))
 	}
+	var finishCalls []*txTraceResult
+	if api.isPoSA && failed == nil {
+		if sysTxs == nil {
+			preCalls, failed = sysCalls.trace(func() error {
+				return api.posa.TraceFinalizeCalls(api.backend.ChainHeaderReader(), header, statedb, len(txs) > 0, sysCalls)
+			})
+		}
+		if failed == nil {
+			finishCalls, failed = sysCalls.trace(func() error {
+				return api.posa.TraceFinishProposals(api.backend.ChainHeaderReader(), header, statedb, sysTxs, sysCalls)
+			})
+		}
+	}
 	close(jobs)
 	pend.Wait()
 
@@ -688,7 +753,15 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 	if failed != nil {
 		return nil, failed
 	}
-	return results, nil
+	if len(preCalls) == 0 && len(finishCalls) == 0 {
+		return results, nil
+	}
+	// Place the system calls where they were executed
+	merged := make([]*txTraceResult, 0, len(results)+len(preCalls)+len(finishCalls))
+	merged = append(merged, results[:firstSys]...)
+	merged = append(merged, preCalls...)
+	merged = append(merged, results[firstSys:]...)
+	return append(merged, finishCalls...), nil
 }
 
 // standardTraceBlockToFile configures a new tracer which uses standard JSON output,
@@ -862,14 +935,24 @@ func (api *API) TraceTransaction(ctx context.Context, hash common.Hash, config *
 		TxIndex:   int(index),
 		TxHash:    hash,
 	}
+	var policy *txPolicyEnv
 	if api.isPoSA {
 		tx := block.Transactions()[int(index)]
 		ok, _ := api.posa.IsSysTransaction(msg.From(), tx, block.Header())
 		if ok {
 			return api.tracePoSASysTx(ctx, msg.From(), tx, txctx, vmctx, statedb, config)
 		}
+		// The starting state of the block is only regenerated if the tracer
+		// replays the policy checks.
+		policy = &txPolicyEnv{
+			header: block.Header(),
+			state: func() (*state.StateDB, error) {
+				_, _, statedb, err := api.backend.StateAtTransaction(ctx, block, 0, reexec)
+				return statedb, err
+			},
+		}
 	}
-	return api.traceTx(ctx, msg, txctx, vmctx, statedb, config)
+	return api.traceTx(ctx, msg, txctx, vmctx, statedb, policy, config)
 }
 
 // TraceCall lets you trace a given eth_call. It collects the structured logs
@@ -926,13 +1009,32 @@ func (api *API) TraceCall(ctx context.Context, args ethapi.TransactionArgs, bloc
 			Reexec:    config.Reexec,
 		}
 	}
-	return api.traceTx(ctx, msg, new(Context), vmctx, statedb, traceConfig)
+	// The message is checked like the transaction pool does, for the next block
+	// on top of the given one.
+	var policy *txPolicyEnv
+	if api.isPoSA {
+		header := block.Header()
+		policy = &txPolicyEnv{
+			header: &types.Header{
+				ParentHash: header.Hash(),
+				Difficulty: new(big.Int).Set(header.Difficulty),
+				Number:     new(big.Int).Add(header.Number, common.Big1),
+				GasLimit:   header.GasLimit,
+				Time:       header.Time + 1,
+			},
+			state: func() (*state.StateDB, error) {
+				return statedb.Copy(), nil
+			},
+		}
+	}
+	return api.traceTx(ctx, msg, new(Context), vmctx, statedb, policy, traceConfig)
 }
 
 // traceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
-// be tracer dependent.
-func (api *API) traceTx(ctx context.Context, message core.Message, txctx *Context, vmctx vm.BlockContext, statedb *state.StateDB, config *TraceConfig) (interface{}, error) {
+// be tracer dependent. The policy checks are replayed in the given environment,
+// if any and if the tracer wants them.
+func (api *API) traceTx(ctx context.Context, message core.Message, txctx *Context, vmctx vm.BlockContext, statedb *state.StateDB, policy *txPolicyEnv, config *TraceConfig) (interface{}, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
 		tracer    vm.EVMLogger
@@ -966,6 +1068,18 @@ func (api *API) traceTx(ctx context.Context, message core.Message, txctx *Contex
 	default:
 		tracer = vm.NewStructLogger(config.LogConfig)
 	}
+	// Replay the transaction-level policy checks first if the tracer wants them,
+	// a message which would be refused by the txpool is not executed at all.
+	if logger, ok := tracer.(vm.PolicyLogger); ok && policy != nil {
+		policyState, err := policy.state()
+		if err != nil {
+			return nil, err
+		}
+		if err := api.posa.TraceTxPolicy(message.From(), message.To(), message.Value(), message.Data(), policy.header, policyState, logger.CapturePolicyCheck); err != nil {
+			tracer.CaptureEnd(nil, 0, 0, err)
+			return api.traceResult(tracer, &core.ExecutionResult{Err: err})
+		}
+	}
 	// Run the transaction with tracing enabled.
 	vmenv := vm.NewEVM(vmctx, txContext, statedb, api.backend.ChainConfig(), vm.Config{Debug: true, Tracer: tracer, NoBaseFee: true})
 
@@ -1032,6 +1146,78 @@ func (api *API) tracePoSASysTx(ctx context.Context, sender common.Address, tx *t
 	})
 }
 
+// sysCallTracer traces the engine-internal system calls of a PoSA block with the
+// tracer configured for the block trace, collecting a synthetic trace result for
+// each of them.
+type sysCallTracer struct {
+	api    *API
+	ctx    context.Context
+	config *TraceConfig
+	txctx  *Context
+
+	tracer  vm.EVMLogger       // tracer of the running system call
+	cancel  context.CancelFunc // cancels the timeout of the running system call
+	results []*txTraceResult
+}
+
+// trace runs the given system calls, returning their trace results.
+func (t *sysCallTracer) trace(run func() error) ([]*txTraceResult, error) {
+	t.results = nil
+	if err := run(); err != nil {
+		return nil, err
+	}
+	return t.results, nil
+}
+
+// StartSysCall implements consensus.SysCallTracer, creating the tracer of a system call.
+func (t *sysCallTracer) StartSysCall(method string) vm.EVMLogger {
+	t.tracer, t.cancel = nil, nil
+	switch {
+	case t.config == nil:
+		t.tracer = vm.NewStructLogger(nil)
+	case t.config.Tracer != nil:
+		timeout := defaultTraceTimeout
+		if t.config.Timeout != nil {
+			if d, err := time.ParseDuration(*t.config.Timeout); err == nil {
+				timeout = d
+			}
+		}
+		tracer, err := New(*t.config.Tracer, t.txctx)
+		if err != nil {
+			t.results = append(t.results, &txTraceResult{SysCall: method, Error: err.Error()})
+			return nil
+		}
+		deadlineCtx, cancel := context.WithTimeout(t.ctx, timeout)
+		go func() {
+			<-deadlineCtx.Done()
+			if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
+				tracer.Stop(errors.New("execution timeout"))
+			}
+		}()
+		t.tracer, t.cancel = tracer, cancel
+	default:
+		t.tracer = vm.NewStructLogger(t.config.LogConfig)
+	}
+	return t.tracer
+}
+
+// EndSysCall implements consensus.SysCallTracer, collecting the trace result of a
+// system call.
+func (t *sysCallTracer) EndSysCall(method string, ret []byte, err error) {
+	if t.cancel != nil {
+		defer t.cancel()
+	}
+	if t.tracer == nil {
+		return
+	}
+	res, traceErr := t.api.traceResult(t.tracer, &core.ExecutionResult{Err: err, ReturnData: ret})
+	if traceErr != nil {
+		t.results = append(t.results, &txTraceResult{SysCall: method, Error: traceErr.Error()})
+		return
+	}
+	t.results = append(t.results, &txTraceResult{SysCall: method, Result: res})
+}
+
 func (api *API) traceResult(tracer vm.EVMLogger, result *core.ExecutionResult) (interface{}, error) {
 	// Depending on the tracer type, format and return the output.
 	switch tracer := tracer.(type) {
@@ -26,6 +26,7 @@ import (
 	"math/big"
 	"reflect"
 	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -419,6 +420,31 @@ func TestTraceBlock(t *testing.T) {
 	}
 }
 
+func TestSysCallTracer(t *testing.T) {
+	t.Parallel()
+
+	tracer := &sysCallTracer{api: new(API), ctx: context.Background(), txctx: &Context{}}
+	calls, err := tracer.trace(func() error {
+		if logger := tracer.StartSysCall("distributeBlockReward"); logger == nil {
+			t.Fatal("no tracer for the system call")
+		}
+		tracer.EndSysCall("distributeBlockReward", []byte{0x01}, nil)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to trace system calls: %v", err)
+	}
+	have, _ := json.Marshal(calls)
+	want := `[{"systemCall":"distributeBlockReward","result":{"gas":0,"failed":false,"returnValue":"01","structLogs":[]}}]`
+	if string(have) != want {
+		t.Fatalf("system call trace mismatch, have\n%v\n, want\n%v\n", string(have), want)
+	}
+	// A failing run drops the collected results
+	if calls, err = tracer.trace(func() error { return errors.New("boom") }); err == nil || calls != nil {
+		t.Fatalf("failed run returned results %v, err %v", calls, err)
+	}
+}
+
 func TestTracingWithOverrides(t *testing.T) {
 	t.Parallel()
 	// Initialize test accounts
@@ -541,6 +567,144 @@ func TestTracingWithOverrides(t *testing.T) {
 	}
 }
 
+// policyEngine is a PoSA engine recording the environments the policy checks of
+// the traced transactions are replayed in. The PoSA methods not used by the
+// tracers are left unimplemented.
+type policyEngine struct {
+	consensus.PoSA
+	engine consensus.Engine
+	sender common.Address
+
+	lock   sync.Mutex
+	checks []policyEnvCheck
+}
+
+// policyEnvCheck is a policy check seen by the policyEngine: the header it ran
+// for and the nonce of the sender in the state it read.
+type policyEnvCheck struct {
+	parent common.Hash
+	number uint64
+	nonce  uint64
+}
+
+func (e *policyEngine) Author(header *types.Header) (common.Address, error) {
+	return e.engine.Author(header)
+}
+
+func (e *policyEngine) PreHandle(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB) error {
+	return nil
+}
+
+func (e *policyEngine) IsSysTransaction(sender common.Address, tx *types.Transaction, header *types.Header) (bool, error) {
+	return false, nil
+}
+
+func (e *policyEngine) CanCreate(state consensus.StateReader, addr common.Address, height *big.Int) bool {
+	return true
+}
+
+func (e *policyEngine) CanTransferByWhitelist(state consensus.StateReader, addr common.Address, height *big.Int) bool {
+	return true
+}
+
+func (e *policyEngine) CreateEvmExtraValidator(header *types.Header, parentState *state.StateDB) types.EvmExtraValidator {
+	return nil
+}
+
+func (e *policyEngine) TraceFinalizeCalls(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, hasTxs bool, tracer consensus.SysCallTracer) error {
+	return nil
+}
+
+func (e *policyEngine) TraceFinishProposals(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, sysTxs []*types.Transaction, tracer consensus.SysCallTracer) error {
+	return nil
+}
+
+func (e *policyEngine) TraceTxPolicy(sender common.Address, to *common.Address, value *big.Int, data []byte, header *types.Header, parentState *state.StateDB, hook types.PolicyHook) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.checks = append(e.checks, policyEnvCheck{header.ParentHash, header.Number.Uint64(), parentState.GetNonce(e.sender)})
+	return nil
+}
+
+// policyCheckTracer is a struct logger which wants the policy checks replayed.
+type policyCheckTracer struct {
+	*vm.StructLogger
+}
+
+func (t *policyCheckTracer) CapturePolicyCheck(check *types.PolicyCheck) {}
+func (t *policyCheckTracer) GetResult() (json.RawMessage, error)         { return json.RawMessage(`{}`), nil }
+func (t *policyCheckTracer) Stop(err error)                              {}
+
+func init() {
+	RegisterLookup(false, func(name string, ctx *Context) (Tracer, error) {
+		if name != "policyCheckTracer" {
+			return nil, errors.New("no tracer found")
+		}
+		return &policyCheckTracer{vm.NewStructLogger(nil)}, nil
+	})
+}
+
+func TestTracePolicyEnvironment(t *testing.T) {
+	t.Parallel()
+
+	// Initialize test accounts
+	accounts := newAccounts(2)
+	genesis := &core.Genesis{Alloc: core.GenesisAlloc{
+		accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+	}}
+	genBlocks := 3
+	signer := types.HomesteadSigner{}
+	backend := newTestBackend(t, genBlocks, genesis, func(i int, b *core.BlockGen) {
+		// Two transfers from account[0] to account[1] per block
+		for j := 0; j < 2; j++ {
+			tx, _ := types.SignTx(types.NewTransaction(uint64(2*i+j), accounts[1].addr, big.NewInt(1000), params.TxGas, b.BaseFee(), nil), signer, accounts[0].key)
+			b.AddTx(tx)
+		}
+	})
+	engine := &policyEngine{engine: backend.engine, sender: accounts[0].addr}
+	backend.engine = engine
+	api := NewAPI(backend)
+
+	tracer := "policyCheckTracer"
+	block := backend.chain.GetBlockByNumber(2)
+	header := block.Header()
+	take := func() []policyEnvCheck {
+		engine.lock.Lock()
+		defer engine.lock.Unlock()
+		checks := engine.checks
+		engine.checks = nil
+		return checks
+	}
+	// The transactions of a block are checked for the block itself, against the
+	// state the block started from.
+	atBlock := policyEnvCheck{header.ParentHash, 2, 2}
+	if _, err := api.TraceBlockByNumber(context.Background(), 2, &TraceConfig{Tracer: &tracer}); err != nil {
+		t.Fatalf("failed to trace block: %v", err)
+	}
+	if checks := take(); !reflect.DeepEqual(checks, []policyEnvCheck{atBlock, atBlock}) {
+		t.Errorf("block trace checks mismatch: have %+v, want %+v", checks, []policyEnvCheck{atBlock, atBlock})
+	}
+	if _, err := api.TraceTransaction(context.Background(), block.Transactions()[1].Hash(), &TraceConfig{Tracer: &tracer}); err != nil {
+		t.Fatalf("failed to trace transaction: %v", err)
+	}
+	if checks := take(); !reflect.DeepEqual(checks, []policyEnvCheck{atBlock}) {
+		t.Errorf("transaction trace checks mismatch: have %+v, want %+v", checks, []policyEnvCheck{atBlock})
+	}
+	// A call is checked for the next block, against the state of the given one.
+	_, err := api.TraceCall(context.Background(), ethapi.TransactionArgs{
+		From:  &accounts[0].addr,
+		To:    &accounts[1].addr,
+		Value: (*hexutil.Big)(big.NewInt(1000)),
+	}, rpc.BlockNumberOrHashWithNumber(2), &TraceCallConfig{Tracer: &tracer})
+	if err != nil {
+		t.Fatalf("failed to trace call: %v", err)
+	}
+	next := policyEnvCheck{block.Hash(), 3, 4}
+	if checks := take(); !reflect.DeepEqual(checks, []policyEnvCheck{next}) {
+		t.Errorf("call trace checks mismatch: have %+v, want %+v", checks, []policyEnvCheck{next})
+	}
+}
+
 type Account struct {
 	key  *ecdsa.PrivateKey
 	addr common.Address
@@ -0,0 +1,174 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+func init() {
+	register("policyTracer", newPolicyTracer)
+}
+
+type policyCheck struct {
+	Depth      int      `json:"depth"`
+	Path       []string `json:"path,omitempty"`
+	Kind       string   `json:"kind"`
+	Address    string   `json:"address"`
+	CheckType  string   `json:"checkType"`
+	Denied     bool     `json:"denied"`
+	EventSig   string   `json:"eventSig,omitempty"`
+	Location   string   `json:"location,omitempty"`
+	TopicIndex *int     `json:"topicIndex,omitempty"`
+	ArrayIndex *int     `json:"arrayIndex,omitempty"`
+}
+
+type policyResult struct {
+	Checks  []policyCheck `json:"checks"`
+	Denials []policyCheck `json:"denials"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// policyTracer records every blacklist and whitelist check made by the PoSA
+// engine during a transaction, together with the call frames they happened in.
+// Checks made before the execution starts (the ones of ValidateTx) are reported
+// at depth 0.
+//
+// Example:
+//   > debug.traceTransaction("0x...", {tracer: "policyTracer"})
+//   {
+//     checks: [...],
+//     denials: [{
+//       depth: 2,
+//       path: ["0x...", "0x..."],
+//       kind: "eventRule",
+//       address: "0x...",
+//       checkType: "to",
+//       denied: true,
+//       eventSig: "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+//       location: "topic",
+//       topicIndex: 2
+//     }],
+//     error: "address denied"
+//   }
+type policyTracer struct {
+	env       *vm.EVM
+	frames    []string // addresses of the currently entered call frames
+	result    policyResult
+	interrupt uint32 // Atomic flag to signal execution interruption
+	reason    error  // Textual reason for the interruption
+}
+
+// newPolicyTracer returns a native go tracer which records policy checks,
+// and implements vm.EVMLogger and vm.PolicyLogger.
+func newPolicyTracer() tracers.Tracer {
+	return &policyTracer{
+		result: policyResult{
+			Checks:  make([]policyCheck, 0),
+			Denials: make([]policyCheck, 0),
+		},
+	}
+}
+
+// CapturePolicyCheck implements the PolicyLogger interface to record a policy check.
+func (t *policyTracer) CapturePolicyCheck(check *types.PolicyCheck) {
+	rec := policyCheck{
+		Depth:     len(t.frames),
+		Path:      append([]string(nil), t.frames...),
+		Kind:      check.Kind.String(),
+		Address:   addrToHex(check.Address),
+		CheckType: check.CheckType.String(),
+		Denied:    check.Denied,
+	}
+	if check.Kind == types.PolicyEventRule {
+		idx := check.TopicIndex
+		rec.EventSig = check.EventSig.Hex()
+		rec.Location = check.Location.String()
+		rec.TopicIndex = &idx
+		if check.Location == common.LocationDataArray {
+			arrayIdx := check.ArrayIndex
+			rec.ArrayIndex = &arrayIdx
+		}
+	}
+	t.result.Checks = append(t.result.Checks, rec)
+	if rec.Denied {
+		t.result.Denials = append(t.result.Denials, rec)
+	}
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *policyTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.env = env
+	t.frames = append(t.frames[:0], addrToHex(to))
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *policyTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *policyTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+		return
+	}
+	t.frames = append(t.frames, addrToHex(to))
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *policyTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if len(t.frames) > 1 {
+		t.frames = t.frames[:len(t.frames)-1]
+	}
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (t *policyTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *policyTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	if err != nil {
+		t.result.Error = err.Error()
+	}
+}
+
+// GetResult returns the json-encoded policy checks, and any error arising
+// from the encoding or forceful termination (via `Stop`).
+func (t *policyTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.result)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *policyTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
@@ -0,0 +1,179 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// testPolicyValidator denies the calls to and from the blacklisted addresses,
+// and the events whose rule points to a blacklisted address in a topic.
+type testPolicyValidator struct {
+	blacklist map[common.Address]bool
+	rules     map[common.Hash]int // Event signature -> index of the checked topic
+	hook      types.PolicyHook
+}
+
+func (v *testPolicyValidator) IsAddressDenied(address common.Address, cType common.AddressCheckType) bool {
+	denied := v.blacklist[address]
+	if v.hook != nil {
+		v.hook(&types.PolicyCheck{Kind: types.PolicyBlacklist, Address: address, CheckType: cType, Denied: denied})
+	}
+	return denied
+}
+
+func (v *testPolicyValidator) IsLogDenied(log *types.Log) bool {
+	idx, ok := v.rules[log.Topics[0]]
+	if !ok || idx >= len(log.Topics) {
+		return false
+	}
+	address := common.BytesToAddress(log.Topics[idx].Bytes())
+	denied := v.blacklist[address]
+	if v.hook != nil {
+		v.hook(&types.PolicyCheck{
+			Kind:       types.PolicyEventRule,
+			Address:    address,
+			CheckType:  common.CheckTo,
+			Denied:     denied,
+			EventSig:   log.Topics[0],
+			Location:   common.LocationTopic,
+			TopicIndex: idx,
+		})
+	}
+	return denied
+}
+
+func (v *testPolicyValidator) WithHook(hook types.PolicyHook) types.EvmExtraValidator {
+	cpy := *v
+	cpy.hook = hook
+	return &cpy
+}
+
+// push returns the code pushing the given word on the stack.
+func push(word []byte) []byte {
+	return append([]byte{byte(vm.PUSH1) + byte(len(word)-1)}, word...)
+}
+
+func TestPolicyTracer(t *testing.T) {
+	var (
+		caller   = common.HexToAddress("0xca11e7")
+		proxy    = common.HexToAddress("0x9702")
+		payer    = common.HexToAddress("0x9a7e7")
+		emitter  = common.HexToAddress("0xe717")
+		banned   = common.HexToAddress("0xbad")
+		transfer = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+		validator = &testPolicyValidator{
+			blacklist: map[common.Address]bool{banned: true},
+			rules:     map[common.Hash]int{transfer: 2},
+		}
+	)
+	// call(gas, to, value, 0, 0, 0, 0)
+	call := func(to common.Address, value byte) []byte {
+		code := []byte{byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0}
+		code = append(append(code, push([]byte{value})...), push(to.Bytes())...)
+		return append(code, byte(vm.GAS), byte(vm.CALL), byte(vm.STOP))
+	}
+	// log3(0, 0, Transfer, emitter, banned)
+	emit := append(append(append(push(banned.Bytes()), push(emitter.Bytes())...), push(transfer.Bytes())...), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.LOG3), byte(vm.STOP))
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	statedb.SetCode(proxy, call(payer, 0))
+	statedb.SetCode(payer, call(banned, 1))
+	statedb.AddBalance(payer, big.NewInt(1))
+	statedb.SetCode(emitter, emit)
+
+	tests := []struct {
+		name   string
+		to     common.Address
+		checks int // Number of checks, allowed or not
+		want   policyCheck
+	}{
+		{
+			name:   "blacklisted transfer",
+			to:     proxy,
+			checks: 4, // both ends of the two inner calls
+			want: policyCheck{
+				Depth:     2,
+				Path:      []string{addrToHex(proxy), addrToHex(payer)},
+				Kind:      "blacklist",
+				Address:   addrToHex(banned),
+				CheckType: common.CheckTo.String(),
+				Denied:    true,
+			},
+		},
+		{
+			name:   "event rule",
+			to:     emitter,
+			checks: 1,
+			want: policyCheck{
+				Depth:      1,
+				Path:       []string{addrToHex(emitter)},
+				Kind:       "eventRule",
+				Address:    addrToHex(banned),
+				CheckType:  common.CheckTo.String(),
+				Denied:     true,
+				EventSig:   transfer.Hex(),
+				Location:   common.LocationTopic.String(),
+				TopicIndex: new(int),
+			},
+		},
+	}
+	*tests[1].want.TopicIndex = 2
+
+	for _, tt := range tests {
+		tracer := newPolicyTracer()
+		blockCtx := vm.BlockContext{
+			CanTransfer:    core.CanTransfer,
+			Transfer:       core.Transfer,
+			BlockNumber:    big.NewInt(1),
+			ExtraValidator: validator,
+		}
+		evm := vm.NewEVM(blockCtx, vm.TxContext{Origin: caller}, statedb.Copy(), params.TestChainConfig, vm.Config{Debug: true, Tracer: tracer})
+		evm.Call(vm.AccountRef(caller), tt.to, nil, 1000000, new(big.Int))
+
+		blob, err := tracer.GetResult()
+		if err != nil {
+			t.Fatalf("%s: failed to get result: %v", tt.name, err)
+		}
+		var result policyResult
+		if err := json.Unmarshal(blob, &result); err != nil {
+			t.Fatalf("%s: failed to decode result: %v", tt.name, err)
+		}
+		if len(result.Denials) != 1 {
+			t.Fatalf("%s: denials mismatch: %+v", tt.name, result.Denials)
+		}
+		if !reflect.DeepEqual(result.Denials[0], tt.want) {
+			t.Errorf("%s: denial mismatch:\nhave %+v\nwant %+v", tt.name, result.Denials[0], tt.want)
+		}
+		if len(result.Checks) != tt.checks {
+			t.Errorf("%s: checks mismatch: have %d, want %d", tt.name, len(result.Checks), tt.checks)
+		}
+	}
+}
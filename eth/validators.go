@@ -0,0 +1,225 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+const (
+	validatorHeadChanSize   = 10 // Size of the channel listening to chain head events
+	validatorCandidatesSize = 16 // Number of validator nodes queued for dialing
+)
+
+// validatorNodes tracks the nodes proven by their "congress" ENR entry to be run
+// by the current validators. Blocks and transactions are relayed to the validator
+// peers first, discovery prefers dialing validator nodes, and validator nodes keep
+// a full mesh with each other.
+type validatorNodes struct {
+	engine *congress.Congress
+	chain  *core.BlockChain
+
+	nodes      map[enode.ID]*enode.Node // Nodes run by the current validators
+	meshed     map[enode.ID]*enode.Node // Nodes added as static peers for the mesh
+	lock       sync.RWMutex
+	candidates *nodeQueue
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newValidatorNodes(engine *congress.Congress, chain *core.BlockChain) *validatorNodes {
+	return &validatorNodes{
+		engine:     engine,
+		chain:      chain,
+		nodes:      make(map[enode.ID]*enode.Node),
+		meshed:     make(map[enode.ID]*enode.Node),
+		candidates: newNodeQueue(validatorCandidatesSize),
+		quit:       make(chan struct{}),
+	}
+}
+
+// contains checks whether the given node is run by a current validator.
+func (v *validatorNodes) contains(id enode.ID) bool {
+	if v == nil {
+		return false
+	}
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	_, ok := v.nodes[id]
+	return ok
+}
+
+// check verifies whether the given node is run by a current validator, tracking
+// it if so. It returns whether the node wasn't tracked yet.
+func (v *validatorNodes) check(node *enode.Node) bool {
+	validator, ok := v.engine.ENRValidator(v.chain, v.chain.CurrentBlock().Hash(), node)
+	if !ok {
+		return false
+	}
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if old, known := v.nodes[node.ID()]; known && old.Seq() >= node.Seq() {
+		return false
+	}
+	log.Debug("Found validator node", "validator", validator, "id", node.ID(), "addr", node.IP())
+	v.nodes[node.ID()] = node
+	return true
+}
+
+// start runs the discovery of the validator nodes and maintains the mesh with
+// them.
+func (v *validatorNodes) start(srv *p2p.Server) {
+	if it := srv.DiscoveryNodes(); it != nil {
+		v.wg.Add(1)
+		go v.discoverLoop(it)
+	}
+	v.wg.Add(1)
+	go v.meshLoop(srv)
+}
+
+// stop terminates the discovery and the mesh maintenance.
+func (v *validatorNodes) stop() {
+	close(v.quit)
+	v.candidates.Close()
+	v.wg.Wait()
+}
+
+// discoverLoop checks the discovered nodes, and queues the new validator nodes for
+// dialing.
+func (v *validatorNodes) discoverLoop(it enode.Iterator) {
+	defer v.wg.Done()
+
+	go func() {
+		<-v.quit
+		it.Close()
+	}()
+	for it.Next() {
+		if node := it.Node(); v.check(node) {
+			v.candidates.push(node)
+		}
+	}
+}
+
+// meshLoop drops the nodes of the former validators on new heads and, while the
+// local node is a validator, keeps the validator nodes as static peers.
+func (v *validatorNodes) meshLoop(srv *p2p.Server) {
+	defer v.wg.Done()
+
+	heads := make(chan core.ChainHeadEvent, validatorHeadChanSize)
+	sub := v.chain.SubscribeChainHeadEvent(heads)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case ev := <-heads:
+			v.update(srv, ev.Block.Hash())
+		case <-sub.Err():
+			return
+		case <-v.quit:
+			return
+		}
+	}
+}
+
+// update re-checks the tracked nodes at the given head and updates the mesh.
+func (v *validatorNodes) update(srv *p2p.Server, head common.Hash) {
+	v.lock.Lock()
+	for id, node := range v.nodes {
+		if _, ok := v.engine.ENRValidator(v.chain, head, node); !ok {
+			delete(v.nodes, id)
+		}
+	}
+	var (
+		mesh   = v.engine.IsValidator(v.chain, head)
+		add    []*enode.Node
+		remove []*enode.Node
+	)
+	for id, node := range v.meshed {
+		if _, ok := v.nodes[id]; !ok || !mesh {
+			remove = append(remove, node)
+			delete(v.meshed, id)
+		}
+	}
+	if mesh {
+		for id, node := range v.nodes {
+			if _, ok := v.meshed[id]; !ok && id != srv.LocalNode().ID() {
+				add = append(add, node)
+				v.meshed[id] = node
+			}
+		}
+	}
+	v.lock.Unlock()
+
+	for _, node := range remove {
+		log.Debug("Removing validator node from mesh", "id", node.ID())
+		srv.RemovePeer(node)
+	}
+	for _, node := range add {
+		log.Debug("Adding validator node to mesh", "id", node.ID())
+		srv.AddPeer(node)
+	}
+}
+
+// nodeQueue is an iterator of the nodes pushed to it, dropping them if nobody
+// consumes them.
+type nodeQueue struct {
+	ch     chan *enode.Node
+	closed chan struct{}
+	once   sync.Once
+	node   *enode.Node
+}
+
+func newNodeQueue(size int) *nodeQueue {
+	return &nodeQueue{ch: make(chan *enode.Node, size), closed: make(chan struct{})}
+}
+
+func (q *nodeQueue) push(node *enode.Node) {
+	select {
+	case q.ch <- node:
+	default:
+	}
+}
+
+// Next implements enode.Iterator, blocking until a node is pushed.
+func (q *nodeQueue) Next() bool {
+	select {
+	case q.node = <-q.ch:
+		return true
+	case <-q.closed:
+		return false
+	}
+}
+
+// Node implements enode.Iterator.
+func (q *nodeQueue) Node() *enode.Node {
+	return q.node
+}
+
+// Close implements enode.Iterator.
+func (q *nodeQueue) Close() {
+	q.once.Do(func() { close(q.closed) })
+}
@@ -0,0 +1,51 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/eth/protocols/eth"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+// Tests that the peers run by the validators are relayed to first.
+func TestValidatorsFirst(t *testing.T) {
+	validators := &validatorNodes{nodes: make(map[enode.ID]*enode.Node)}
+	h := &handler{validators: validators}
+
+	var peers []*ethPeer
+	for i := byte(0); i < 6; i++ {
+		id := enode.ID{i}
+		peers = append(peers, &ethPeer{Peer: &eth.Peer{Peer: p2p.NewPeer(id, "", nil)}})
+		if i == 2 || i == 5 {
+			validators.nodes[id] = nil
+		}
+	}
+	if n := h.validatorsFirst(peers); n != 2 {
+		t.Fatalf("validator peer count mismatch: have %d, want 2", n)
+	}
+	for i, peer := range peers[:2] {
+		if id := peer.Node().ID(); !validators.contains(id) {
+			t.Errorf("peer %d: not a validator: %v", i, id)
+		}
+	}
+	if n := (&handler{}).validatorsFirst(peers); n != 0 {
+		t.Fatalf("validator peers found without congress: %d", n)
+	}
+}
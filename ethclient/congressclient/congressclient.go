@@ -0,0 +1,205 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package congressclient provides an RPC client for the congress consensus APIs
+// and the transaction pool extensions of congress nodes.
+package congressclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/congress"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Client is a wrapper around rpc.Client that implements the congress specific
+// functionality.
+//
+// If you want to use the standardized Ethereum RPC functionality, use ethclient.Client instead.
+type Client struct {
+	c *rpc.Client
+}
+
+// Dial connects a client to the given URL.
+func Dial(rawurl string) (*Client, error) {
+	return DialContext(context.Background(), rawurl)
+}
+
+// DialContext connects a client to the given URL with the given context.
+func DialContext(ctx context.Context, rawurl string) (*Client, error) {
+	c, err := rpc.DialContext(ctx, rawurl)
+	if err != nil {
+		return nil, err
+	}
+	return New(c), nil
+}
+
+// New creates a client that uses the given RPC client.
+func New(c *rpc.Client) *Client {
+	return &Client{c}
+}
+
+// Close closes the underlying RPC connection.
+func (ec *Client) Close() {
+	ec.c.Close()
+}
+
+// Snapshot is the state of the validator set at a given block.
+type Snapshot struct {
+	Number     uint64                    `json:"number"`           // Block number where the snapshot was created
+	Hash       common.Hash               `json:"hash"`             // Block hash where the snapshot was created
+	Validators []common.Address          `json:"validators"`       // Authorized validators, in ascending order
+	Recents    map[uint64]common.Address `json:"recents"`          // Recent validators by the block they signed
+	Params     *congress.Params          `json:"params,omitempty"` // Governed consensus parameters, nil for the defaults
+}
+
+// UnmarshalJSON decodes a snapshot, whose validators are sent as a set.
+func (s *Snapshot) UnmarshalJSON(input []byte) error {
+	type snapshot Snapshot
+	var dec struct {
+		snapshot
+		Validators map[common.Address]struct{} `json:"validators"`
+	}
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	*s = Snapshot(dec.snapshot)
+	s.Validators = make([]common.Address, 0, len(dec.Validators))
+	for validator := range dec.Validators {
+		s.Validators = append(s.Validators, validator)
+	}
+	sort.Slice(s.Validators, func(i, j int) bool {
+		return bytes.Compare(s.Validators[i][:], s.Validators[j][:]) < 0
+	})
+	return nil
+}
+
+// Status is the sealing status of the recent blocks.
+type Status struct {
+	InturnPercent float64                `json:"inturnPercent"`  // Percentage of blocks sealed in-turn
+	SigningStatus map[common.Address]int `json:"sealerActivity"` // Number of blocks sealed by each validator
+	NumBlocks     uint64                 `json:"numBlocks"`      // Number of blocks the status covers
+}
+
+// GetSnapshot retrieves the state snapshot at the given block. The block number
+// can be nil, in which case the snapshot is taken from the latest known block.
+func (ec *Client) GetSnapshot(ctx context.Context, number *big.Int) (*Snapshot, error) {
+	var snap *Snapshot
+	err := ec.c.CallContext(ctx, &snap, "congress_getSnapshot", toBlockNumArg(number))
+	return snap, err
+}
+
+// GetSnapshotAtHash retrieves the state snapshot at the given block.
+func (ec *Client) GetSnapshotAtHash(ctx context.Context, hash common.Hash) (*Snapshot, error) {
+	var snap *Snapshot
+	err := ec.c.CallContext(ctx, &snap, "congress_getSnapshotAtHash", hash)
+	return snap, err
+}
+
+// GetValidators retrieves the authorized validators at the given block. The block
+// number can be nil, in which case the validators are taken from the latest known
+// block.
+func (ec *Client) GetValidators(ctx context.Context, number *big.Int) ([]common.Address, error) {
+	var validators []common.Address
+	err := ec.c.CallContext(ctx, &validators, "congress_getValidators", toBlockNumArg(number))
+	return validators, err
+}
+
+// GetValidatorsAtHash retrieves the authorized validators at the given block.
+func (ec *Client) GetValidatorsAtHash(ctx context.Context, hash common.Hash) ([]common.Address, error) {
+	var validators []common.Address
+	err := ec.c.CallContext(ctx, &validators, "congress_getValidatorsAtHash", hash)
+	return validators, err
+}
+
+// GetParams retrieves the consensus parameters in effect for the block after the
+// given one. The block number can be nil, in which case the latest known block
+// is used.
+func (ec *Client) GetParams(ctx context.Context, number *big.Int) (*congress.Params, error) {
+	var params *congress.Params
+	err := ec.c.CallContext(ctx, &params, "congress_getParams", toBlockNumArg(number))
+	return params, err
+}
+
+// Status retrieves the sealing status of the recent blocks.
+func (ec *Client) Status(ctx context.Context) (*Status, error) {
+	var status *Status
+	err := ec.c.CallContext(ctx, &status, "congress_status")
+	return status, err
+}
+
+// GetPolicyHistory retrieves the blacklist, whitelist and admin changes of the
+// given address, in chain order. The changes of the newest 256+16 blocks at most
+// are not indexed yet.
+func (ec *Client) GetPolicyHistory(ctx context.Context, address common.Address) ([]*congress.PolicyChange, error) {
+	var changes []*congress.PolicyChange
+	err := ec.c.CallContext(ctx, &changes, "congress_getPolicyHistory", address)
+	return changes, err
+}
+
+// GetRewards retrieves the fee distribution of the blocks sealed by the given
+// validator between the from and to blocks (both inclusive). The to block can
+// be nil, in which case the latest known block is used.
+func (ec *Client) GetRewards(ctx context.Context, validator common.Address, from, to *big.Int) ([]*congress.RewardRecord, error) {
+	var records []*congress.RewardRecord
+	err := ec.c.CallContext(ctx, &records, "congress_getRewards", validator, toBlockNumArg(from), toBlockNumArg(to))
+	return records, err
+}
+
+// GetValidatorSetHistory retrieves the changes of the validator set done by the
+// epoch blocks between the from and to blocks (both inclusive). The to block can
+// be nil, in which case the latest known block is used.
+func (ec *Client) GetValidatorSetHistory(ctx context.Context, from, to *big.Int) ([]*congress.ValidatorSetChange, error) {
+	var changes []*congress.ValidatorSetChange
+	err := ec.c.CallContext(ctx, &changes, "congress_getValidatorSetHistory", toBlockNumArg(from), toBlockNumArg(to))
+	return changes, err
+}
+
+// SubscribeRewards subscribes to the fee distribution of the new blocks.
+func (ec *Client) SubscribeRewards(ctx context.Context, ch chan<- *congress.RewardRecord) (*rpc.ClientSubscription, error) {
+	return ec.c.Subscribe(ctx, "congress", ch, "rewards")
+}
+
+// SubscribeValidatorSetChanges subscribes to the changes of the validator set done
+// by the new epoch blocks.
+func (ec *Client) SubscribeValidatorSetChanges(ctx context.Context, ch chan<- *congress.ValidatorSetChange) (*rpc.ClientSubscription, error) {
+	return ec.c.EthSubscribe(ctx, ch, "validatorSetChanges")
+}
+
+// JamIndex retrieves the jam index of the transaction pool, evaluated from the
+// pending transactions.
+func (ec *Client) JamIndex(ctx context.Context) (int, error) {
+	var index int
+	err := ec.c.CallContext(ctx, &index, "txpool_jamIndex")
+	return index, err
+}
+
+func toBlockNumArg(number *big.Int) string {
+	if number == nil {
+		return "latest"
+	}
+	pending := big.NewInt(-1)
+	if number.Cmp(pending) == 0 {
+		return "pending"
+	}
+	return hexutil.EncodeBig(number)
+}
@@ -0,0 +1,178 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package congressclient
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/congress"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	// The admin of the system contracts on test chains, allowed to transfer
+	// without being whitelisted
+	testKey, _  = crypto.HexToECDSA("5ea30eea9ba9500f3601f7659f0ccace819c562456e2f745fb2555918ab32277")
+	testAddr    = crypto.PubkeyToAddress(testKey.PublicKey)
+	testBalance = new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.Ether))
+)
+
+// newTestBackend starts a node sealing a congress chain with a single validator.
+func newTestBackend(t *testing.T, key *ecdsa.PrivateKey) *node.Node {
+	validator := crypto.PubkeyToAddress(key.PublicKey)
+
+	data, err := ioutil.ReadFile("../../example-genesis.json")
+	if err != nil {
+		t.Fatalf("can't read genesis: %v", err)
+	}
+	genesis := new(core.Genesis)
+	if err := json.Unmarshal(data, genesis); err != nil {
+		t.Fatalf("can't parse genesis: %v", err)
+	}
+	genesis.Config.ChainID = big.NewInt(1337)
+	genesis.Config.Congress.Period = 1
+	genesis.Timestamp = uint64(time.Now().Unix())
+	genesis.ExtraData = append(append(make([]byte, 32), validator[:]...), make([]byte, crypto.SignatureLength)...)
+	genesis.Alloc[testAddr] = core.GenesisAccount{Balance: testBalance}
+
+	n, err := node.New(&node.Config{})
+	if err != nil {
+		t.Fatalf("can't create new node: %v", err)
+	}
+	config := ethconfig.Defaults
+	config.Genesis = genesis
+	config.NetworkId = 1337
+	config.Miner.Etherbase = validator
+	config.Miner.GasCeil = genesis.GasLimit
+
+	ethservice, err := eth.New(n, &config)
+	if err != nil {
+		t.Fatalf("can't create new ethereum service: %v", err)
+	}
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.ImportECDSA(key, "")
+	if err == nil {
+		err = ks.Unlock(account, "")
+	}
+	if err != nil {
+		t.Fatalf("can't import validator key: %v", err)
+	}
+	n.AccountManager().AddBackend(ks)
+
+	if err := n.Start(); err != nil {
+		t.Fatalf("can't start test node: %v", err)
+	}
+	if err := ethservice.StartMining(1); err != nil {
+		t.Fatalf("can't start sealing: %v", err)
+	}
+	return n
+}
+
+func TestCongressClient(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	validator := crypto.PubkeyToAddress(key.PublicKey)
+	backend := newTestBackend(t, key)
+	defer backend.Close()
+
+	rpcClient, err := backend.Attach()
+	if err != nil {
+		t.Fatalf("can't attach to node: %v", err)
+	}
+	var (
+		client = New(rpcClient)
+		ec     = ethclient.NewClient(rpcClient)
+		ctx    = context.Background()
+	)
+	rewards := make(chan *congress.RewardRecord, 1)
+	sub, err := client.SubscribeRewards(ctx, rewards)
+	if err != nil {
+		t.Fatalf("can't subscribe to rewards: %v", err)
+	}
+	defer sub.Unsubscribe()
+
+	// Seal a block carrying a fee
+	chainID, _ := ec.ChainID(ctx)
+	tx, _ := types.SignTx(types.NewTransaction(0, common.Address{0x01}, big.NewInt(1), 21000, big.NewInt(10*params.GWei), nil), types.LatestSignerForChainID(chainID), testKey)
+	if err := ec.SendTransaction(ctx, tx); err != nil {
+		t.Fatalf("can't send transaction: %v", err)
+	}
+	var reward *congress.RewardRecord
+	select {
+	case reward = <-rewards:
+	case err := <-sub.Err():
+		t.Fatalf("reward subscription failed: %v", err)
+	case <-time.After(30 * time.Second):
+		t.Fatal("no reward notified")
+	}
+	if reward.Validator != validator || reward.Fee.ToInt().Sign() <= 0 {
+		t.Fatalf("reward mismatch: %+v", reward)
+	}
+	records, err := client.GetRewards(ctx, validator, big.NewInt(int64(reward.BlockNumber)), nil)
+	if err != nil || len(records) != 1 || records[0].BlockHash != reward.BlockHash {
+		t.Fatalf("rewards mismatch: %v, err %v", records, err)
+	}
+
+	// Query the validator set at the rewarded block
+	snap, err := client.GetSnapshotAtHash(ctx, reward.BlockHash)
+	if err != nil {
+		t.Fatalf("can't get snapshot: %v", err)
+	}
+	if snap.Number != reward.BlockNumber || len(snap.Validators) != 1 || snap.Validators[0] != validator {
+		t.Fatalf("snapshot mismatch: %+v", snap)
+	}
+	if snap, err = client.GetSnapshot(ctx, nil); err != nil || snap.Number < reward.BlockNumber {
+		t.Fatalf("latest snapshot mismatch: %+v, err %v", snap, err)
+	}
+	validators, err := client.GetValidators(ctx, big.NewInt(int64(reward.BlockNumber)))
+	if err != nil || len(validators) != 1 || validators[0] != validator {
+		t.Fatalf("validators mismatch: %x, err %v", validators, err)
+	}
+	if validators, err = client.GetValidatorsAtHash(ctx, reward.BlockHash); err != nil || len(validators) != 1 {
+		t.Fatalf("validators at hash mismatch: %x, err %v", validators, err)
+	}
+	if params, err := client.GetParams(ctx, nil); err != nil || params.Period != 1 {
+		t.Fatalf("params mismatch: %+v, err %v", params, err)
+	}
+	status, err := client.Status(ctx)
+	if err != nil {
+		t.Fatalf("can't get status: %v", err)
+	}
+	if status.InturnPercent != 100 || status.SigningStatus[validator] != int(status.NumBlocks) {
+		t.Fatalf("status mismatch: %+v", status)
+	}
+	if changes, err := client.GetValidatorSetHistory(ctx, big.NewInt(0), nil); err != nil || len(changes) != 0 {
+		t.Fatalf("validator set history mismatch: %v, err %v", changes, err)
+	}
+	if index, err := client.JamIndex(ctx); err != nil || index != 0 {
+		t.Fatalf("jam index mismatch: %d, err %v", index, err)
+	}
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/mclock"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/congress"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	ethproto "github.com/ethereum/go-ethereum/eth/protocols/eth"
@@ -57,6 +58,10 @@ const (
 	txChanSize = 4096
 	// chainHeadChanSize is the size of channel listening to ChainHeadEvent.
 	chainHeadChanSize = 10
+
+	// congressStatsRange is the number of recent blocks the in-turn percentage
+	// and the punishments of congress nodes are reported for.
+	congressStatsRange = 64
 )
 
 // backend encompasses the bare-minimum functionality needed for ethstats reporting
@@ -80,6 +85,11 @@ type fullNodeBackend interface {
 	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
 }
 
+// jamIndexBackend is a backend tracking the jam index of its transaction pool.
+type jamIndexBackend interface {
+	JamIndex() int
+}
+
 // Service implements an Ethereum netstats reporting daemon that pushes local
 // chain statistics up to a monitoring server.
 type Service struct {
@@ -759,6 +769,15 @@ type nodeStats struct {
 	Peers    int  `json:"peers"`
 	GasPrice int  `json:"gasPrice"`
 	Uptime   int  `json:"uptime"`
+
+	Congress *congressStats `json:"congress,omitempty"`
+}
+
+// congressStats is the information to report about the consensus of congress
+// nodes.
+type congressStats struct {
+	*congress.Stats
+	JamIndex int `json:"jamIndex"`
 }
 
 // reportStats retrieves various stats about the node at the networking and
@@ -789,6 +808,11 @@ func (s *Service) reportStats(conn *connWrapper) error {
 		sync := s.backend.SyncProgress()
 		syncing = s.backend.CurrentHeader().Number.Uint64() >= sync.HighestBlock
 	}
+	// Congress full nodes also report the state of the consensus
+	var cstats *congressStats
+	if engine, ok := s.engine.(*congress.Congress); ok && fullBackend != nil {
+		cstats = s.assembleCongressStats(engine, fullBackend.CurrentHeader())
+	}
 	// Assemble the node stats and send it to the server
 	log.Trace("Sending node details to ethstats")
 
@@ -802,6 +826,7 @@ func (s *Service) reportStats(conn *connWrapper) error {
 			GasPrice: gasprice,
 			Syncing:  syncing,
 			Uptime:   100,
+			Congress: cstats,
 		},
 	}
 	report := map[string][]interface{}{
@@ -809,3 +834,18 @@ func (s *Service) reportStats(conn *connWrapper) error {
 	}
 	return conn.WriteJSON(report)
 }
+
+// assembleCongressStats gathers the consensus overview of a congress node at the
+// given head, or nil if it's unavailable.
+func (s *Service) assembleCongressStats(engine *congress.Congress, head *types.Header) *congressStats {
+	stats, err := engine.Stats(head, congressStatsRange)
+	if err != nil {
+		log.Debug("Failed to assemble congress stats", "number", head.Number, "err", err)
+		return nil
+	}
+	jam := -1
+	if backend, ok := s.backend.(jamIndexBackend); ok {
+		jam = backend.JamIndex()
+	}
+	return &congressStats{Stats: stats, JamIndex: jam}
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/congress"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
+)
+
+// Reward represents the fee distribution of a congress block.
+type Reward struct {
+	record *congress.RewardRecord
+}
+
+func (r *Reward) Validator() common.Address {
+	return r.record.Validator
+}
+
+func (r *Reward) VotePool() common.Address {
+	return r.record.VotePool
+}
+
+func (r *Reward) Fee() hexutil.Big {
+	return *r.record.Fee
+}
+
+func (r *Reward) Foundation() hexutil.Big {
+	return *r.record.Foundation
+}
+
+func (r *Reward) ValidatorReward() hexutil.Big {
+	return *r.record.Reward
+}
+
+func (r *Reward) Stakers() hexutil.Big {
+	return *r.record.Stakers
+}
+
+func (r *Reward) Burn() hexutil.Big {
+	if r.record.Burn == nil {
+		return hexutil.Big{}
+	}
+	return *r.record.Burn
+}
+
+// isCongress tells whether the chain is sealed by the congress engine.
+func isCongress(backend ethapi.Backend) bool {
+	_, ok := backend.Engine().(*congress.Congress)
+	return ok
+}
+
+func (b *Block) Validator(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || header.Number.Sign() == 0 {
+		return nil, err
+	}
+	validator, err := b.backend.Engine().Author(header)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		backend:       b.backend,
+		address:       validator,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+func (b *Block) InTurn(ctx context.Context) (*bool, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || !isCongress(b.backend) {
+		return nil, err
+	}
+	inturn := congress.IsInTurn(header)
+	return &inturn, nil
+}
+
+func (b *Block) EpochValidators(ctx context.Context) (*[]common.Address, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || !isCongress(b.backend) {
+		return nil, err
+	}
+	validators, err := congress.EpochValidators(header)
+	if err != nil || len(validators) == 0 {
+		return nil, err
+	}
+	return &validators, nil
+}
+
+func (b *Block) Reward(ctx context.Context) (*Reward, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil || !isCongress(b.backend) || header.Number.Sign() == 0 {
+		return nil, err
+	}
+	validator, err := b.backend.Engine().Author(header)
+	if err != nil {
+		return nil, err
+	}
+	record, err := congress.ReadRewardRecord(b.backend.ChainDb(), validator, header.Number.Uint64(), header.Hash())
+	if err != nil || record == nil {
+		return nil, err
+	}
+	return &Reward{record}, nil
+}
+
+func (t *Transaction) IsSystemTransaction(ctx context.Context) (*bool, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx == nil || t.block == nil {
+		return nil, err
+	}
+	posa, ok := t.backend.Engine().(consensus.PoSA)
+	if !ok {
+		return nil, nil
+	}
+	header, err := t.block.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from, err := types.Sender(types.LatestSigner(t.backend.ChainConfig()), tx)
+	if err != nil {
+		return nil, err
+	}
+	system, err := posa.IsSysTransaction(from, tx, header)
+	if err != nil {
+		return nil, err
+	}
+	return &system, nil
+}
+
+func (t *Transaction) FeePayer(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	tx, err := t.resolve(ctx)
+	if err != nil || tx == nil || !types.IsMetaTransaction(tx.Data()) {
+		return nil, err
+	}
+	// The expiry of the meta data is checked against the block of the
+	// transaction, or the current block for pending ones
+	number := t.backend.CurrentHeader().Number
+	if t.block != nil {
+		header, err := t.block.resolveHeader(ctx)
+		if err != nil {
+			return nil, err
+		}
+		number = header.Number
+	}
+	meta, err := types.DecodeMetaData(tx.Data(), number)
+	if err != nil {
+		return nil, err
+	}
+	from, err := types.Sender(types.LatestSigner(t.backend.ChainConfig()), tx)
+	if err != nil {
+		return nil, err
+	}
+	payer, err := meta.ParseMetaData(tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), meta.Payload, from, t.backend.ChainConfig().ChainID)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		backend:       t.backend,
+		address:       payer,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
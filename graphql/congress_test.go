@@ -0,0 +1,276 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package graphql
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// The admin of the system contracts on test chains, the only account allowed to
+// transfer without being whitelisted.
+const congressAdminKey = "5ea30eea9ba9500f3601f7659f0ccace819c562456e2f745fb2555918ab32277"
+
+func TestGraphQLCongressFields(t *testing.T) {
+	var (
+		admin, _     = crypto.HexToECDSA(congressAdminKey)
+		validator, _ = crypto.GenerateKey()
+		adminAddr    = crypto.PubkeyToAddress(admin.PublicKey)
+		validatorAdr = crypto.PubkeyToAddress(validator.PublicKey)
+		to           = common.HexToAddress("0x0000000000000000000000000000000000000dad")
+	)
+	stack := createCongressNode(t, validator, adminAddr)
+	defer stack.Close()
+
+	rpcClient, _ := stack.Attach()
+	client := ethclient.NewClient(rpcClient)
+	chainID, _ := client.ChainID(context.Background())
+
+	// Send a plain transfer and a meta transfer paid by the validator
+	var (
+		signer   = types.LatestSignerForChainID(chainID)
+		gasPrice = big.NewInt(10 * params.GWei)
+	)
+	transfer, _ := types.SignTx(types.NewTransaction(0, to, big.NewInt(1), 21000, gasPrice, nil), signer, admin)
+	meta, _ := types.SignTx(newMetaTransaction(1, adminAddr, to, gasPrice, 5000, 1000, validator, chainID), signer, admin)
+	for _, tx := range []*types.Transaction{transfer, meta} {
+		if err := client.SendTransaction(context.Background(), tx); err != nil {
+			t.Fatalf("failed to send transaction: %v", err)
+		}
+	}
+	for _, tx := range []*types.Transaction{transfer, meta} {
+		receipt := waitReceipt(t, client, tx.Hash())
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("transaction %x failed", tx.Hash())
+		}
+	}
+
+	var genesis struct {
+		Block struct {
+			Validator       *struct{ Address common.Address }
+			InTurn          *bool
+			EpochValidators []common.Address
+		}
+	}
+	queryGraphQL(t, stack, `{block(number:0){validator{address} inTurn epochValidators}}`, &genesis)
+	if genesis.Block.Validator != nil {
+		t.Errorf("genesis validator mismatch: have %x, want null", genesis.Block.Validator.Address)
+	}
+	if len(genesis.Block.EpochValidators) != 1 || genesis.Block.EpochValidators[0] != validatorAdr {
+		t.Errorf("epoch validators mismatch: have %x, want [%x]", genesis.Block.EpochValidators, validatorAdr)
+	}
+
+	type txResult struct {
+		IsSystemTransaction *bool
+		FeePayer            *struct{ Address common.Address }
+		Block               struct {
+			Validator       struct{ Address common.Address }
+			Miner           struct{ Address common.Address }
+			InTurn          *bool
+			EpochValidators []common.Address
+			Reward          *struct {
+				Validator       common.Address
+				Fee             hexutil.Big
+				Foundation      hexutil.Big
+				ValidatorReward hexutil.Big
+				Stakers         hexutil.Big
+				Burn            hexutil.Big
+			}
+		}
+	}
+	query := `{transaction(hash:"%s"){isSystemTransaction feePayer{address} block{validator{address} miner{address} inTurn epochValidators reward{validator fee foundation validatorReward stakers burn}}}}`
+
+	var result struct{ Transaction txResult }
+	queryGraphQL(t, stack, fmt.Sprintf(query, transfer.Hash().Hex()), &result)
+	tx := result.Transaction
+	if tx.IsSystemTransaction == nil || *tx.IsSystemTransaction {
+		t.Errorf("system transaction mismatch: have %v, want false", tx.IsSystemTransaction)
+	}
+	if tx.FeePayer != nil {
+		t.Errorf("fee payer mismatch: have %x, want null", tx.FeePayer.Address)
+	}
+	if tx.Block.Validator.Address != validatorAdr || tx.Block.Miner.Address != validatorAdr {
+		t.Errorf("validator mismatch: have %x (miner %x), want %x", tx.Block.Validator.Address, tx.Block.Miner.Address, validatorAdr)
+	}
+	if tx.Block.InTurn == nil || !*tx.Block.InTurn {
+		t.Errorf("in-turn mismatch: have %v, want true", tx.Block.InTurn)
+	}
+	if tx.Block.EpochValidators != nil {
+		t.Errorf("epoch validators of a non-epoch block: %x", tx.Block.EpochValidators)
+	}
+
+	// The reward of the block is recorded once the block is inserted
+	for deadline := time.Now().Add(5 * time.Second); tx.Block.Reward == nil && time.Now().Before(deadline); time.Sleep(100 * time.Millisecond) {
+		queryGraphQL(t, stack, fmt.Sprintf(query, transfer.Hash().Hex()), &result)
+		tx = result.Transaction
+	}
+	if tx.Block.Reward == nil {
+		t.Fatal("block reward missing")
+	}
+	if tx.Block.Reward.Validator != validatorAdr || tx.Block.Reward.Fee.ToInt().Sign() <= 0 {
+		t.Errorf("reward mismatch: validator %x, fee %v", tx.Block.Reward.Validator, tx.Block.Reward.Fee.ToInt())
+	}
+	sum := new(big.Int).Add(tx.Block.Reward.Foundation.ToInt(), tx.Block.Reward.ValidatorReward.ToInt())
+	sum.Add(sum, tx.Block.Reward.Stakers.ToInt())
+	if sum.Add(sum, tx.Block.Reward.Burn.ToInt()).Cmp(tx.Block.Reward.Fee.ToInt()) != 0 {
+		t.Errorf("reward shares mismatch: have %v, want fee %v", sum, tx.Block.Reward.Fee.ToInt())
+	}
+
+	queryGraphQL(t, stack, fmt.Sprintf(query, meta.Hash().Hex()), &result)
+	if payer := result.Transaction.FeePayer; payer == nil || payer.Address != validatorAdr {
+		t.Errorf("fee payer mismatch: have %v, want %x", payer, validatorAdr)
+	}
+}
+
+// createCongressNode starts a node sealing a congress chain with the given
+// validator key, funding the given accounts.
+func createCongressNode(t *testing.T, validator *ecdsa.PrivateKey, funds ...common.Address) *node.Node {
+	data, err := ioutil.ReadFile("../example-genesis.json")
+	if err != nil {
+		t.Fatalf("failed to read genesis: %v", err)
+	}
+	genesis := new(core.Genesis)
+	if err := json.Unmarshal(data, genesis); err != nil {
+		t.Fatalf("failed to parse genesis: %v", err)
+	}
+	addr := crypto.PubkeyToAddress(validator.PublicKey)
+	genesis.Config.ChainID = big.NewInt(1337)
+	genesis.Config.Congress.Period = 1
+	genesis.Timestamp = uint64(time.Now().Unix())
+	genesis.ExtraData = append(append(make([]byte, 32), addr[:]...), make([]byte, crypto.SignatureLength)...)
+	for _, fund := range append(funds, addr) {
+		genesis.Alloc[fund] = core.GenesisAccount{Balance: new(big.Int).Mul(big.NewInt(1000), big.NewInt(params.Ether))}
+	}
+
+	stack, err := node.New(&node.Config{
+		HTTPHost: "127.0.0.1",
+		HTTPPort: 0,
+	})
+	if err != nil {
+		t.Fatalf("could not create node: %v", err)
+	}
+	ethConf := ethconfig.Defaults
+	ethConf.Genesis = genesis
+	ethConf.NetworkId = 1337
+	ethConf.Miner.Etherbase = addr
+	ethConf.Miner.GasCeil = genesis.GasLimit
+
+	ethBackend, err := eth.New(stack, &ethConf)
+	if err != nil {
+		t.Fatalf("could not create eth backend: %v", err)
+	}
+	if err := New(stack, ethBackend.APIBackend, []string{}, []string{}); err != nil {
+		t.Fatalf("could not create graphql service: %v", err)
+	}
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	account, err := ks.ImportECDSA(validator, "")
+	if err == nil {
+		err = ks.Unlock(account, "")
+	}
+	if err != nil {
+		t.Fatalf("could not import validator key: %v", err)
+	}
+	stack.AccountManager().AddBackend(ks)
+
+	if err := stack.Start(); err != nil {
+		t.Fatalf("could not start node: %v", err)
+	}
+	if err := ethBackend.StartMining(1); err != nil {
+		t.Fatalf("could not start sealing: %v", err)
+	}
+	return stack
+}
+
+// newMetaTransaction creates an unsigned meta transaction transferring a wei,
+// its fee share paid by the payer.
+func newMetaTransaction(nonce uint64, from, to common.Address, gasPrice *big.Int, feePercent, blockNumLimit uint64, payer *ecdsa.PrivateKey, chainID *big.Int) *types.Transaction {
+	const gas = 50000
+	amount := big.NewInt(1)
+
+	enc, _ := rlp.EncodeToBytes([]interface{}{
+		nonce, gasPrice, uint64(gas), &to, amount, []byte{}, from, feePercent, blockNumLimit, chainID,
+	})
+	sig, _ := crypto.Sign(crypto.Keccak256(enc), payer)
+	v := new(big.Int).Mul(chainID, big.NewInt(2))
+	v.Add(v, big.NewInt(int64(sig[64])+35))
+
+	meta, _ := rlp.EncodeToBytes(&types.MetaData{
+		BlockNumLimit: blockNumLimit,
+		FeePercent:    feePercent,
+		V:             v,
+		R:             new(big.Int).SetBytes(sig[:32]),
+		S:             new(big.Int).SetBytes(sig[32:64]),
+		Payload:       []byte{},
+	})
+	data := append(common.FromHex(types.MetaPrefix), meta...)
+	return types.NewTransaction(nonce, to, amount, gas, gasPrice, data)
+}
+
+func waitReceipt(t *testing.T, client *ethclient.Client, hash common.Hash) *types.Receipt {
+	for deadline := time.Now().Add(30 * time.Second); time.Now().Before(deadline); time.Sleep(200 * time.Millisecond) {
+		if receipt, _ := client.TransactionReceipt(context.Background(), hash); receipt != nil {
+			return receipt
+		}
+	}
+	t.Fatalf("transaction %x not included", hash)
+	return nil
+}
+
+// queryGraphQL runs a query against the node, decoding its data into result.
+func queryGraphQL(t *testing.T, stack *node.Node, query string, result interface{}) {
+	body, _ := json.Marshal(map[string]string{"query": query})
+	resp, err := http.Post(fmt.Sprintf("%s/graphql", stack.HTTPEndpoint()), "application/json", strings.NewReader(string(body)))
+	if err != nil {
+		t.Fatalf("could not post: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var response struct {
+		Data   json.RawMessage
+		Errors []interface{}
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		t.Fatalf("could not decode response: %v", err)
+	}
+	if len(response.Errors) > 0 {
+		t.Fatalf("query %s failed: %v", query, response.Errors)
+	}
+	if err := json.Unmarshal(response.Data, result); err != nil {
+		t.Fatalf("could not decode data: %v", err)
+	}
+}
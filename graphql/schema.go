@@ -138,6 +138,14 @@ const schema string = `
         #Envelope transaction support
         type: Int
         accessList: [AccessTuple!]
+        # IsSystemTransaction tells whether this transaction is a system or
+        # governance transaction of the congress validator sealing the block. If
+        # the transaction has not yet been mined, or the chain is not sealed by
+        # congress, this field will be null.
+        isSystemTransaction: Boolean
+        # FeePayer is the account paying the fee share of a meta transaction. This
+        # is null if the transaction is not a meta transaction.
+        feePayer(block: Long): Account
     }
 
     # BlockFilterCriteria encapsulates log filter criteria for a filter applied
@@ -231,6 +239,39 @@ const schema string = `
         # EstimateGas estimates the amount of gas that will be required for
         # successful execution of a transaction at the current block's state.
         estimateGas(data: CallData!): Long!
+        # Validator is the account that sealed this block, recovered from the
+        # seal rather than taken from the coinbase. This will be null for the
+        # genesis block.
+        validator(block: Long): Account
+        # InTurn tells whether this block was sealed by the in-turn validator. If
+        # the chain is not sealed by congress, this field will be null.
+        inTurn: Boolean
+        # EpochValidators is the validator set embedded into the extra-data of an
+        # epoch block. This will be null for other blocks, or if the chain is not
+        # sealed by congress.
+        epochValidators: [Address!]
+        # Reward is the distribution of the fees of this block, as recorded by the
+        # node. This will be null if the block carries no fee, or was not recorded.
+        reward: Reward
+    }
+
+    # Reward is the distribution of the fees of a congress block between the
+    # foundation, the validator and its stakers.
+    type Reward {
+        # Validator is the address of the validator that sealed the block.
+        validator: Address!
+        # VotePool is the address of the vote pool of the validator's stakers.
+        votePool: Address!
+        # Fee is the total fee of the block, in wei.
+        fee: BigInt!
+        # Foundation is the share of the foundation, in wei.
+        foundation: BigInt!
+        # ValidatorReward is the share of the validator, in wei.
+        validatorReward: BigInt!
+        # Stakers is the share of the validator's stakers, in wei.
+        stakers: BigInt!
+        # Burn is the share burnt by the validators contract, in wei.
+        burn: BigInt!
     }
 
     # CallData represents the data associated with a local contract call.
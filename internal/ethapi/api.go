@@ -1901,36 +1901,42 @@ check tx meta transaction format.
 */
 func metaTransactionCheck(ctx context.Context, tx *types.Transaction, b Backend) error {
 	if types.IsMetaTransaction(tx.Data()) {
-		metaData, err := types.DecodeMetaData(tx.Data(), b.CurrentBlock().Number())
-		if err != nil {
-			return err
-		}
-
 		signer := types.MakeSigner(b.ChainConfig(), b.CurrentBlock().Number())
 		from, err := signer.Sender(tx)
 		if err != nil {
 			return err
 		}
-
-		addr, err := metaData.ParseMetaData(tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), metaData.Payload, from, b.ChainConfig().ChainID)
-		if err != nil {
-			return err
-		}
-		if err := metaFeecheck(ctx, tx, metaData, addr, b); err != nil {
+		state, header, err := b.StateAndHeaderByNumber(ctx, rpc.BlockNumber(b.CurrentBlock().Number().Int64()))
+		if state == nil || err != nil {
 			return err
 		}
-		log.Debug("metaTransfer found, feeaddr:", addr.Hex()+" feePercent : "+strconv.FormatUint(metaData.FeePercent, 10))
+		return metaDataCheck(b.ChainConfig().ChainID, state, header.Number, from, tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data())
 	}
 	return nil
 }
 
-func metaFeecheck(ctx context.Context, tx *types.Transaction, metaData *types.MetaData, feeAddr common.Address, b Backend) error {
-	mgval := new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), tx.GasPrice())
-	mgFeeAddrVal := new(big.Int).Div(new(big.Int).Mul(mgval, new(big.Int).SetUint64(metaData.FeePercent)), types.BIG10000) //value will deduct from fee address
-	state, _, err := b.StateAndHeaderByNumber(ctx, rpc.BlockNumber(b.CurrentBlock().Number().Int64()))
-	if state == nil || err != nil {
+// metaDataCheck checks the meta data of a meta transaction given by its fields
+// at the given block, and whether the fee address can pay its share of the fee
+// out of the given state.
+func metaDataCheck(chainID *big.Int, state *state.StateDB, number *big.Int, from common.Address, nonce uint64, gasPrice *big.Int, gas uint64, to *common.Address, value *big.Int, data []byte) error {
+	metaData, err := types.DecodeMetaData(data, number)
+	if err != nil {
+		return err
+	}
+	addr, err := metaData.ParseMetaData(nonce, gasPrice, gas, to, value, metaData.Payload, from, chainID)
+	if err != nil {
+		return err
+	}
+	if err := metaFeecheck(state, gasPrice, gas, metaData, addr); err != nil {
 		return err
 	}
+	log.Debug("metaTransfer found, feeaddr:", addr.Hex()+" feePercent : "+strconv.FormatUint(metaData.FeePercent, 10))
+	return nil
+}
+
+func metaFeecheck(state *state.StateDB, gasPrice *big.Int, gas uint64, metaData *types.MetaData, feeAddr common.Address) error {
+	mgval := new(big.Int).Mul(new(big.Int).SetUint64(gas), gasPrice)
+	mgFeeAddrVal := new(big.Int).Div(new(big.Int).Mul(mgval, new(big.Int).SetUint64(metaData.FeePercent)), types.BIG10000) //value will deduct from fee address
 	feeAddrBalance := state.GetBalance(feeAddr)
 
 	if feeAddrBalance.Cmp(mgFeeAddrVal) < 0 {
@@ -0,0 +1,240 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Stages of the policy pipeline a transaction can be denied at.
+const (
+	policyStageTransaction = "transaction"     // checks done by the txpool and the miner
+	policyStageMeta        = "metaTransaction" // meta transaction data and fee checks
+	policyStageExecution   = "execution"       // checks done by the EVM during execution
+)
+
+// PolicyVerdict is the result of a transaction policy pre-check. If the
+// transaction is denied, it tells the stage and the rule which denied it.
+type PolicyVerdict struct {
+	Allowed   bool            `json:"allowed"`
+	Stage     string          `json:"stage,omitempty"`
+	Rule      string          `json:"rule,omitempty"`
+	Address   *common.Address `json:"address,omitempty"`
+	CheckType string          `json:"checkType,omitempty"`
+	EventSig  *common.Hash    `json:"eventSig,omitempty"`
+	Location  string          `json:"location,omitempty"`
+	Index     *int            `json:"index,omitempty"`
+	Depth     int             `json:"depth,omitempty"` // Call depth of an execution denial
+	Error     string          `json:"error,omitempty"`
+
+	// ExecutionError is any execution failure not caused by a policy (e.g. a
+	// revert), which doesn't deny the transaction by itself.
+	ExecutionError string `json:"executionError,omitempty"`
+
+	// CaughtDenials are the denials of inner calls and events whose failure was
+	// handled by the calling contract, so they didn't deny the transaction.
+	CaughtDenials []*PolicyFinding `json:"caughtDenials,omitempty"`
+}
+
+// PolicyFinding is a denying policy check made during the execution.
+type PolicyFinding struct {
+	Rule      string         `json:"rule"`
+	Address   common.Address `json:"address"`
+	CheckType string         `json:"checkType"`
+	EventSig  *common.Hash   `json:"eventSig,omitempty"`
+	Location  string         `json:"location,omitempty"`
+	Index     *int           `json:"index,omitempty"`
+	Depth     int            `json:"depth"`
+}
+
+// policyDenial is a denying policy check and the call depth it was made at.
+type policyDenial struct {
+	check *types.PolicyCheck
+	depth int
+}
+
+// finding converts the denial for the verdict.
+func (d *policyDenial) finding() *PolicyFinding {
+	finding := &PolicyFinding{
+		Rule:      d.check.Kind.String(),
+		Address:   d.check.Address,
+		CheckType: d.check.CheckType.String(),
+		Depth:     d.depth,
+	}
+	if d.check.Kind == types.PolicyEventRule {
+		idx := d.check.TopicIndex
+		finding.EventSig, finding.Location, finding.Index = &d.check.EventSig, d.check.Location.String(), &idx
+	}
+	return finding
+}
+
+// policyCollector is an EVM logger which remembers the denying policy checks of
+// a transaction.
+type policyCollector struct {
+	depth   int
+	denials []*policyDenial
+}
+
+// CapturePolicyCheck implements vm.PolicyLogger.
+func (c *policyCollector) CapturePolicyCheck(check *types.PolicyCheck) {
+	if check.Denied {
+		c.denials = append(c.denials, &policyDenial{check: check, depth: c.depth})
+	}
+}
+
+func (c *policyCollector) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	c.depth = 1
+}
+
+func (c *policyCollector) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+}
+
+func (c *policyCollector) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	c.depth++
+}
+
+func (c *policyCollector) CaptureExit(output []byte, gasUsed uint64, err error) {
+	c.depth--
+}
+
+func (c *policyCollector) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+}
+
+func (c *policyCollector) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) {}
+
+// verdict builds the denying verdict of the given stage. The denial is the last
+// one, the check which aborted the transaction, and the ones before it were
+// caught by the calling contracts.
+func (c *policyCollector) verdict(stage string, err error) *PolicyVerdict {
+	verdict := &PolicyVerdict{Stage: stage, Error: err.Error()}
+	if len(c.denials) == 0 {
+		return verdict
+	}
+	finding := c.denials[len(c.denials)-1].finding()
+	verdict.Rule, verdict.Address, verdict.CheckType = finding.Rule, &finding.Address, finding.CheckType
+	verdict.EventSig, verdict.Location, verdict.Index = finding.EventSig, finding.Location, finding.Index
+	if stage == policyStageExecution {
+		verdict.Depth = finding.Depth
+	}
+	verdict.CaughtDenials = c.findings(len(c.denials) - 1)
+	return verdict
+}
+
+// findings returns the first n denials.
+func (c *policyCollector) findings(n int) []*PolicyFinding {
+	var findings []*PolicyFinding
+	for _, denial := range c.denials[:n] {
+		findings = append(findings, denial.finding())
+	}
+	return findings
+}
+
+// CheckTransactionPolicy tells whether the given transaction would be accepted
+// by the chain policies on top of the given block (latest by default), without
+// sending it. It runs the checks of the transaction pool (blacklist and
+// whitelists), the meta transaction checks, and a traced execution to catch the
+// denials made inside the EVM, reporting the failing rule and address.
+func (s *PublicBlockChainAPI) CheckTransactionPolicy(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (*PolicyVerdict, error) {
+	posa, isPoSA := s.b.Engine().(consensus.PoSA)
+	if !isPoSA {
+		return nil, errors.New("not a PoSA engine")
+	}
+	bNrOrHash := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	if blockNrOrHash != nil {
+		bNrOrHash = *blockNrOrHash
+	}
+	state, header, err := s.b.StateAndHeaderByNumberOrHash(ctx, bNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	msg, err := args.ToMessage(s.b.RPCGasCap(), header.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+	// Run the checks of the transaction pool against the next block
+	next := &types.Header{
+		ParentHash: header.Hash(),
+		Difficulty: new(big.Int).Set(header.Difficulty),
+		Number:     new(big.Int).Add(header.Number, common.Big1),
+		GasLimit:   header.GasLimit,
+		Time:       header.Time + 1,
+	}
+	collector := new(policyCollector)
+	if err := posa.TraceTxPolicy(msg.From(), msg.To(), msg.Value(), msg.Data(), next, state.Copy(), collector.CapturePolicyCheck); err != nil {
+		return collector.verdict(policyStageTransaction, err), nil
+	}
+	// Meta transactions are bound to the sender's nonce, so use the real one. The
+	// expiry and the fee payer's balance are checked at the requested block.
+	if types.IsMetaTransaction(msg.Data()) {
+		nonce := state.GetNonce(msg.From())
+		if args.Nonce != nil {
+			nonce = uint64(*args.Nonce)
+		}
+		if err := metaDataCheck(s.b.ChainConfig().ChainID, state, header.Number, msg.From(), nonce, msg.GasPrice(), msg.Gas(), msg.To(), msg.Value(), msg.Data()); err != nil {
+			return &PolicyVerdict{Stage: policyStageMeta, Rule: policyStageMeta, Error: err.Error()}, nil
+		}
+		msg = types.NewMessage(msg.From(), msg.To(), nonce, msg.Value(), msg.Gas(), msg.GasPrice(), msg.GasFeeCap(), msg.GasTipCap(), msg.Data(), msg.AccessList(), true)
+	}
+	// Execute the transaction to catch the denials of inner calls and events
+	timeout := s.b.RPCEVMTimeout()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	collector = new(policyCollector)
+	evm, vmError, err := s.b.GetEVM(ctx, msg, state, header, &vm.Config{NoBaseFee: true, Debug: true, Tracer: collector})
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		evm.Cancel()
+	}()
+	result, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(math.MaxUint64))
+	if err := vmError(); err != nil {
+		return nil, err
+	}
+	if evm.Cancelled() {
+		return nil, fmt.Errorf("execution aborted (timeout = %v)", timeout)
+	}
+	if err == nil {
+		err = result.Err
+	}
+	// The transaction is denied only if a denial aborted it, the denials of the
+	// inner calls handled by their callers are reported along an allowed verdict
+	if errors.Is(err, types.ErrAddressDenied) {
+		return collector.verdict(policyStageExecution, err), nil
+	}
+	verdict := &PolicyVerdict{Allowed: true, CaughtDenials: collector.findings(len(collector.denials))}
+	if err != nil {
+		verdict.ExecutionError = err.Error()
+	}
+	return verdict, nil
+}
@@ -0,0 +1,278 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package ethapi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// testPolicyEngine is a PoSA engine denying the transactions of blacklisted
+// senders, and the contract creations of the senders out of the whitelist.
+type testPolicyEngine struct {
+	consensus.PoSA
+	blacklist map[common.Address]bool
+	creators  map[common.Address]bool
+}
+
+func (e *testPolicyEngine) TraceTxPolicy(sender common.Address, to *common.Address, value *big.Int, data []byte, header *types.Header, parentState *state.StateDB, hook types.PolicyHook) error {
+	denied := e.blacklist[sender]
+	hook(&types.PolicyCheck{Kind: types.PolicyBlacklist, Address: sender, CheckType: common.CheckFrom, Denied: denied})
+	if denied {
+		return types.ErrAddressDenied
+	}
+	if to == nil {
+		denied = !e.creators[sender]
+		hook(&types.PolicyCheck{Kind: types.PolicyCreateWhitelist, Address: sender, CheckType: common.CheckFrom, Denied: denied})
+		if denied {
+			return types.ErrUnauthorizedCreateTx
+		}
+	}
+	return nil
+}
+
+// testPolicyValidator denies the calls to and from the blacklisted addresses
+// inside the EVM, and the events with a blacklisted address as first topic.
+type testPolicyValidator struct {
+	blacklist map[common.Address]bool
+	hook      types.PolicyHook
+}
+
+func (v *testPolicyValidator) IsAddressDenied(address common.Address, cType common.AddressCheckType) bool {
+	denied := v.blacklist[address]
+	if v.hook != nil {
+		v.hook(&types.PolicyCheck{Kind: types.PolicyBlacklist, Address: address, CheckType: cType, Denied: denied})
+	}
+	return denied
+}
+
+func (v *testPolicyValidator) IsLogDenied(log *types.Log) bool {
+	if len(log.Topics) < 2 {
+		return false
+	}
+	address := common.BytesToAddress(log.Topics[1].Bytes())
+	denied := v.blacklist[address]
+	if v.hook != nil {
+		v.hook(&types.PolicyCheck{Kind: types.PolicyEventRule, Address: address, CheckType: common.CheckTo, Denied: denied, EventSig: log.Topics[0], Location: common.LocationTopic, TopicIndex: 1})
+	}
+	return denied
+}
+
+func (v *testPolicyValidator) WithHook(hook types.PolicyHook) types.EvmExtraValidator {
+	cpy := *v
+	cpy.hook = hook
+	return &cpy
+}
+
+// testPolicyBackend serves a single block and its state. The current block is
+// deliberately unavailable, so all checks must be done at the requested block.
+type testPolicyBackend struct {
+	Backend
+	engine    *testPolicyEngine
+	validator *testPolicyValidator
+	statedb   *state.StateDB
+	header    *types.Header
+}
+
+func (b *testPolicyBackend) Engine() consensus.Engine         { return b.engine }
+func (b *testPolicyBackend) ChainConfig() *params.ChainConfig { return params.TestChainConfig }
+func (b *testPolicyBackend) RPCGasCap() uint64                { return 25000000 }
+func (b *testPolicyBackend) RPCEVMTimeout() time.Duration     { return 0 }
+
+func (b *testPolicyBackend) StateAndHeaderByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*state.StateDB, *types.Header, error) {
+	return b.statedb.Copy(), b.header, nil
+}
+
+func (b *testPolicyBackend) GetEVM(ctx context.Context, msg core.Message, state *state.StateDB, header *types.Header, vmConfig *vm.Config) (*vm.EVM, func() error, error) {
+	context := vm.BlockContext{
+		CanTransfer:    core.CanTransfer,
+		Transfer:       core.Transfer,
+		GetHash:        func(uint64) common.Hash { return common.Hash{} },
+		BlockNumber:    header.Number,
+		Time:           new(big.Int).SetUint64(header.Time),
+		Difficulty:     header.Difficulty,
+		GasLimit:       header.GasLimit,
+		BaseFee:        new(big.Int),
+		ExtraValidator: b.validator,
+	}
+	return vm.NewEVM(context, core.NewEVMTxContext(msg), state, b.ChainConfig(), *vmConfig), func() error { return nil }, nil
+}
+
+// callCode returns the code of a contract calling the given address.
+func callCode(addr common.Address) []byte {
+	code := common.FromHex("0x60006000600060006000") // retSize, retOffset, argsSize, argsOffset, value
+	code = append(append(append(code, byte(vm.PUSH20)), addr.Bytes()...), byte(vm.GAS), byte(vm.CALL), byte(vm.STOP))
+	return code
+}
+
+// emitCode returns the code of a contract emitting an event about the given
+// address.
+func emitCode(addr common.Address) []byte {
+	code := append([]byte{byte(vm.PUSH20)}, addr.Bytes()...)
+	return append(code, byte(vm.PUSH1), 0x01, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.LOG2), byte(vm.STOP)) // log2(0, 0, 1, addr)
+}
+
+// metaData builds the data of a meta transaction whose fee is covered by the
+// given fee payer until the given block.
+func metaData(t *testing.T, feePayer *ecdsa.PrivateKey, args *TransactionArgs, nonce, feePercent, limit uint64) *hexutil.Bytes {
+	chainID := params.TestChainConfig.ChainID
+	enc, err := rlp.EncodeToBytes([]interface{}{
+		nonce, args.GasPrice.ToInt(), uint64(*args.Gas), args.To, args.Value.ToInt(), []byte{}, *args.From, feePercent, limit, chainID,
+	})
+	if err != nil {
+		t.Fatalf("failed to encode meta transaction: %v", err)
+	}
+	sig, err := crypto.Sign(crypto.Keccak256(enc), feePayer)
+	if err != nil {
+		t.Fatalf("failed to sign meta transaction: %v", err)
+	}
+	v := new(big.Int).Add(new(big.Int).Mul(chainID, big.NewInt(2)), big.NewInt(int64(sig[64])+35))
+	meta, err := rlp.EncodeToBytes(&types.MetaData{
+		BlockNumLimit: limit,
+		FeePercent:    feePercent,
+		V:             v,
+		R:             new(big.Int).SetBytes(sig[:32]),
+		S:             new(big.Int).SetBytes(sig[32:64]),
+		Payload:       []byte{},
+	})
+	if err != nil {
+		t.Fatalf("failed to encode meta data: %v", err)
+	}
+	data := hexutil.Bytes(append(common.FromHex(types.MetaPrefix), meta...))
+	return &data
+}
+
+func TestCheckTransactionPolicy(t *testing.T) {
+	var (
+		senderKey, _  = crypto.GenerateKey()
+		sender        = crypto.PubkeyToAddress(senderKey.PublicKey)
+		payerKey, _   = crypto.GenerateKey()
+		payer         = crypto.PubkeyToAddress(payerKey.PublicKey)
+		brokeKey, _   = crypto.GenerateKey()
+		banned        = common.HexToAddress("0xbad")
+		creator       = common.HexToAddress("0xc0de")
+		receiver      = common.HexToAddress("0xfee")
+		callsBanned   = common.HexToAddress("0xca11bad")
+		callsReceiver = common.HexToAddress("0xca11fee")
+		emitsBanned   = common.HexToAddress("0xe717bad")
+
+		blacklist = map[common.Address]bool{banned: true}
+		statedb   = newPolicyTestState(t)
+		backend   = &testPolicyBackend{
+			engine:    &testPolicyEngine{blacklist: blacklist, creators: map[common.Address]bool{creator: true}},
+			validator: &testPolicyValidator{blacklist: blacklist},
+			statedb:   statedb,
+		}
+		api = NewPublicBlockChainAPI(backend)
+	)
+	for _, addr := range []common.Address{sender, payer, banned, creator} {
+		statedb.AddBalance(addr, big.NewInt(params.Ether))
+	}
+	statedb.SetCode(callsBanned, callCode(banned))
+	statedb.SetCode(callsReceiver, callCode(receiver))
+	statedb.SetCode(emitsBanned, emitCode(banned))
+
+	transfer := func(from, to common.Address) TransactionArgs {
+		gas, price := hexutil.Uint64(100000), hexutil.Big(*big.NewInt(params.GWei))
+		return TransactionArgs{From: &from, To: &to, Gas: &gas, GasPrice: &price, Value: new(hexutil.Big)}
+	}
+	meta := func(feePayer *ecdsa.PrivateKey, limit uint64) TransactionArgs {
+		args := transfer(sender, receiver)
+		args.Data = metaData(t, feePayer, &args, 0, 10000, limit)
+		return args
+	}
+	creation := func(from common.Address) TransactionArgs {
+		args := transfer(from, common.Address{})
+		args.To = nil
+		return args
+	}
+	tests := []struct {
+		name    string
+		args    TransactionArgs
+		number  int64
+		stage   string
+		rule    string
+		address common.Address
+		depth   int
+		caught  []common.Address // Denied addresses of the inner calls handled by their callers
+	}{
+		{name: "blacklist pass", args: transfer(sender, receiver)},
+		{name: "blacklist fail", args: transfer(banned, receiver), stage: policyStageTransaction, rule: "blacklist", address: banned},
+		{name: "whitelist pass", args: creation(creator)},
+		{name: "whitelist fail", args: creation(sender), stage: policyStageTransaction, rule: "createWhitelist", address: sender},
+		{name: "meta pass", args: meta(payerKey, 10), number: 10},
+		{name: "meta expired at the requested block", args: meta(payerKey, 10), number: 11, stage: policyStageMeta, rule: policyStageMeta},
+		{name: "meta fee payer broke", args: meta(brokeKey, 10), number: 10, stage: policyStageMeta, rule: policyStageMeta},
+		{name: "execution pass", args: transfer(sender, callsReceiver)},
+		{name: "execution denial caught by the caller", args: transfer(sender, callsBanned), caught: []common.Address{banned}},
+		{name: "execution fail", args: transfer(sender, emitsBanned), stage: policyStageExecution, rule: "eventRule", address: banned, depth: 1},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, tt := range tests {
+		backend.header = &types.Header{Number: big.NewInt(tt.number), Difficulty: common.Big1, GasLimit: 8000000}
+		verdict, err := api.CheckTransactionPolicy(ctx, tt.args, nil)
+		if err != nil {
+			t.Fatalf("%s: failed to check policy: %v", tt.name, err)
+		}
+		if tt.stage == "" {
+			if !verdict.Allowed || verdict.ExecutionError != "" {
+				t.Errorf("%s: transaction denied: %+v", tt.name, verdict)
+			}
+			var caught []common.Address
+			for _, finding := range verdict.CaughtDenials {
+				caught = append(caught, finding.Address)
+			}
+			if !reflect.DeepEqual(caught, tt.caught) {
+				t.Errorf("%s: caught denials mismatch: have %x, want %x", tt.name, caught, tt.caught)
+			}
+			continue
+		}
+		if verdict.Allowed || verdict.Stage != tt.stage || verdict.Rule != tt.rule || verdict.Depth != tt.depth {
+			t.Errorf("%s: verdict mismatch: have %+v, want stage %s rule %s depth %d", tt.name, verdict, tt.stage, tt.rule, tt.depth)
+		}
+		if tt.address != (common.Address{}) && (verdict.Address == nil || *verdict.Address != tt.address) {
+			t.Errorf("%s: denied address mismatch: have %v, want %x", tt.name, verdict.Address, tt.address)
+		}
+	}
+}
+
+func newPolicyTestState(t *testing.T) *state.StateDB {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		t.Fatalf("failed to create state: %v", err)
+	}
+	return statedb
+}
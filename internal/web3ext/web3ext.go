@@ -116,6 +116,30 @@ web3._extend({
 			call: 'congress_getValidatorsAtHash',
 			params: 1
 		}),
+		new web3._extend.Method({
+			name: 'getPolicyHistory',
+			call: 'congress_getPolicyHistory',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getRewards',
+			call: 'congress_getRewards',
+			params: 3,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getValidatorSetHistory',
+			call: 'congress_getValidatorSetHistory',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getParams',
+			call: 'congress_getParams',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
 	]
 });
 `
@@ -188,6 +212,20 @@ web3._extend({
 			call: 'admin_sleepBlocks',
 			params: 2
 		}),
+		new web3._extend.Method({
+			name: 'maxReorgDepth',
+			call: 'admin_maxReorgDepth'
+		}),
+		new web3._extend.Method({
+			name: 'setMaxReorgDepth',
+			call: 'admin_setMaxReorgDepth',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'forceReorg',
+			call: 'admin_forceReorg',
+			params: 1
+		}),
 		new web3._extend.Method({
 			name: 'startHTTP',
 			call: 'admin_startHTTP',
@@ -624,6 +662,12 @@ web3._extend({
 			params: 1,
 			inputFormatter: [web3._extend.formatters.inputAddressFormatter]
 		}),
+		new web3._extend.Method({
+			name: 'checkTransactionPolicy',
+			call: 'eth_checkTransactionPolicy',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputCallFormatter, web3._extend.formatters.inputDefaultBlockNumberFormatter]
+		}),
 	],
 	properties: [
 		new web3._extend.Property({
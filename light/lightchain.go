@@ -491,11 +491,25 @@ func (lc *LightChain) GetHeaderByNumberOdr(ctx context.Context, number uint64) (
 // Config retrieves the header chain's chain configuration.
 func (lc *LightChain) Config() *params.ChainConfig { return lc.hc.Config() }
 
+// epochEngine is a consensus engine verifying the headers from the validator set
+// of a trusted epoch block, like congress whose epoch length may be governed.
+type epochEngine interface {
+	// LatestEpoch returns the latest epoch block at or below the given number,
+	// looking up the trusted headers with the given function. The search starts
+	// from the given epoch length, or the one of the last trusted epoch if zero.
+	LatestEpoch(number uint64, epoch uint64, getHeader func(uint64) (*types.Header, error)) (*types.Header, error)
+
+	// AddTrustedEpoch trusts the given epoch block as the starting point of the
+	// header verification, without its ancestors.
+	AddTrustedEpoch(header *types.Header) error
+}
+
 // SyncCheckpoint fetches the checkpoint point block header according to
 // the checkpoint provided by the remote peer.
 //
 // Note if we are running the clique, fetches the last epoch snapshot header
-// which covered by checkpoint.
+// which covered by checkpoint. If we are running the congress, fetches the last
+// epoch block covered by checkpoint and trusts its validator set.
 func (lc *LightChain) SyncCheckpoint(ctx context.Context, checkpoint *params.TrustedCheckpoint) bool {
 	// Ensure the remote checkpoint head is ahead of us
 	head := lc.CurrentHeader().Number.Uint64()
@@ -507,6 +521,9 @@ func (lc *LightChain) SyncCheckpoint(ctx context.Context, checkpoint *params.Tru
 	if head >= latest {
 		return true
 	}
+	if engine, ok := lc.engine.(epochEngine); ok {
+		return lc.syncEpoch(ctx, engine, latest)
+	}
 	// Retrieve the latest useful header and update to it
 	if header, err := GetHeaderByNumber(ctx, lc.odr, latest); header != nil && err == nil {
 		lc.chainmu.Lock()
@@ -523,6 +540,33 @@ func (lc *LightChain) SyncCheckpoint(ctx context.Context, checkpoint *params.Tru
 	return false
 }
 
+// syncEpoch fetches the latest epoch block header covered by the checkpoint, and
+// trusts it as the starting point of the header verification.
+func (lc *LightChain) syncEpoch(ctx context.Context, engine epochEngine, latest uint64) bool {
+	header, err := engine.LatestEpoch(latest, 0, func(number uint64) (*types.Header, error) {
+		return GetHeaderByNumber(ctx, lc.odr, number)
+	})
+	if err != nil {
+		log.Debug("Failed to retrieve trusted epoch", "checkpoint", latest, "err", err)
+		return false
+	}
+	lc.chainmu.Lock()
+	defer lc.chainmu.Unlock()
+
+	// Ensure the chain didn't move past the epoch block while retrieving it
+	if lc.hc.CurrentHeader().Number.Uint64() >= header.Number.Uint64() {
+		return true
+	}
+	if err := engine.AddTrustedEpoch(header); err != nil {
+		log.Warn("Failed to trust epoch", "number", header.Number, "hash", header.Hash(), "err", err)
+		return false
+	}
+	log.Info("Updated latest header based on trusted epoch", "number", header.Number, "hash", header.Hash(), "age", common.PrettyAge(time.Unix(int64(header.Time), 0)))
+	rawdb.WriteHeadHeaderHash(lc.chainDb, header.Hash())
+	lc.hc.SetCurrentHeader(header)
+	return true
+}
+
 // LockChain locks the chain mutex for reading so that multiple canonical hashes can be
 // retrieved while it is guaranteed that they belong to the same version of the chain
 func (lc *LightChain) LockChain() {
@@ -0,0 +1,194 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Contains a wrapper for the congress consensus client.
+
+package geth
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/consensus/congress"
+	"github.com/ethereum/go-ethereum/ethclient/congressclient"
+)
+
+// CongressClient provides access to the congress consensus and txpool APIs.
+type CongressClient struct {
+	client *congressclient.Client
+}
+
+// NewCongressClient connects a client to the given URL.
+func NewCongressClient(rawurl string) (client *CongressClient, _ error) {
+	rawClient, err := congressclient.Dial(rawurl)
+	return &CongressClient{rawClient}, err
+}
+
+// CongressSnapshot represents the validator set of a congress block.
+type CongressSnapshot struct {
+	snapshot *congressclient.Snapshot
+}
+
+// GetNumber returns the block number of the snapshot.
+func (s *CongressSnapshot) GetNumber() int64 { return int64(s.snapshot.Number) }
+
+// GetHash returns the block hash of the snapshot.
+func (s *CongressSnapshot) GetHash() *Hash { return &Hash{s.snapshot.Hash} }
+
+// GetValidators returns the authorized validators, sorted by address.
+func (s *CongressSnapshot) GetValidators() *Addresses {
+	return &Addresses{s.snapshot.Validators}
+}
+
+// GetRecent returns the validator that sealed the given recent block, or nil if
+// the block is not among the recent ones.
+func (s *CongressSnapshot) GetRecent(number int64) *Address {
+	if validator, ok := s.snapshot.Recents[uint64(number)]; ok {
+		return &Address{validator}
+	}
+	return nil
+}
+
+// CongressStatus represents the sealing activity over the recent blocks.
+type CongressStatus struct {
+	status *congressclient.Status
+}
+
+// GetInturnPercent returns the percentage of blocks sealed in-turn.
+func (s *CongressStatus) GetInturnPercent() float64 { return s.status.InturnPercent }
+
+// GetNumBlocks returns the number of blocks the status was gathered over.
+func (s *CongressStatus) GetNumBlocks() int64 { return int64(s.status.NumBlocks) }
+
+// GetSealedBlocks returns the number of blocks sealed by the given validator.
+func (s *CongressStatus) GetSealedBlocks(validator *Address) int {
+	return s.status.SigningStatus[validator.address]
+}
+
+// CongressReward represents the fee distribution of a congress block.
+type CongressReward struct {
+	record *congress.RewardRecord
+}
+
+// GetBlockNumber returns the number of the rewarded block.
+func (r *CongressReward) GetBlockNumber() int64 { return int64(r.record.BlockNumber) }
+
+// GetBlockHash returns the hash of the rewarded block.
+func (r *CongressReward) GetBlockHash() *Hash { return &Hash{r.record.BlockHash} }
+
+// GetValidator returns the validator that sealed the block.
+func (r *CongressReward) GetValidator() *Address { return &Address{r.record.Validator} }
+
+// GetVotePool returns the vote pool of the validator.
+func (r *CongressReward) GetVotePool() *Address { return &Address{r.record.VotePool} }
+
+// GetFee returns the fees collected in the block.
+func (r *CongressReward) GetFee() *BigInt { return &BigInt{r.record.Fee.ToInt()} }
+
+// GetFoundation returns the share of the foundation.
+func (r *CongressReward) GetFoundation() *BigInt { return &BigInt{r.record.Foundation.ToInt()} }
+
+// GetReward returns the share of the validator.
+func (r *CongressReward) GetReward() *BigInt { return &BigInt{r.record.Reward.ToInt()} }
+
+// GetStakers returns the share of the stakers.
+func (r *CongressReward) GetStakers() *BigInt { return &BigInt{r.record.Stakers.ToInt()} }
+
+// GetBurn returns the burnt share.
+func (r *CongressReward) GetBurn() *BigInt {
+	if r.record.Burn == nil {
+		return &BigInt{new(big.Int)}
+	}
+	return &BigInt{r.record.Burn.ToInt()}
+}
+
+// GetSnapshot returns the validator set snapshot at the given block. If number
+// is <0, the snapshot of the latest known block is returned.
+func (cc *CongressClient) GetSnapshot(ctx *Context, number int64) (snapshot *CongressSnapshot, _ error) {
+	if number < 0 {
+		rawSnapshot, err := cc.client.GetSnapshot(ctx.context, nil)
+		return &CongressSnapshot{rawSnapshot}, err
+	}
+	rawSnapshot, err := cc.client.GetSnapshot(ctx.context, big.NewInt(number))
+	return &CongressSnapshot{rawSnapshot}, err
+}
+
+// GetSnapshotAtHash returns the validator set snapshot at the given block.
+func (cc *CongressClient) GetSnapshotAtHash(ctx *Context, hash *Hash) (snapshot *CongressSnapshot, _ error) {
+	rawSnapshot, err := cc.client.GetSnapshotAtHash(ctx.context, hash.hash)
+	return &CongressSnapshot{rawSnapshot}, err
+}
+
+// GetValidators returns the authorized validators at the given block. If number
+// is <0, the validators of the latest known block are returned.
+func (cc *CongressClient) GetValidators(ctx *Context, number int64) (validators *Addresses, _ error) {
+	if number < 0 {
+		rawValidators, err := cc.client.GetValidators(ctx.context, nil)
+		return &Addresses{rawValidators}, err
+	}
+	rawValidators, err := cc.client.GetValidators(ctx.context, big.NewInt(number))
+	return &Addresses{rawValidators}, err
+}
+
+// GetValidatorsAtHash returns the authorized validators at the given block.
+func (cc *CongressClient) GetValidatorsAtHash(ctx *Context, hash *Hash) (validators *Addresses, _ error) {
+	rawValidators, err := cc.client.GetValidatorsAtHash(ctx.context, hash.hash)
+	return &Addresses{rawValidators}, err
+}
+
+// GetStatus returns the sealing activity over the recent blocks.
+func (cc *CongressClient) GetStatus(ctx *Context) (status *CongressStatus, _ error) {
+	rawStatus, err := cc.client.Status(ctx.context)
+	return &CongressStatus{rawStatus}, err
+}
+
+// GetJamIndex returns the congestion index of the transaction pool.
+func (cc *CongressClient) GetJamIndex(ctx *Context) (index int, _ error) {
+	return cc.client.JamIndex(ctx.context)
+}
+
+// RewardHandler is a client-side subscription callback to invoke on events and
+// subscription failure.
+type RewardHandler interface {
+	OnReward(reward *CongressReward)
+	OnError(failure string)
+}
+
+// SubscribeRewards subscribes to notifications about the fee distribution of
+// the blocks sealed by the node.
+func (cc *CongressClient) SubscribeRewards(ctx *Context, handler RewardHandler, buffer int) (sub *Subscription, _ error) {
+	// Subscribe to the event internally
+	ch := make(chan *congress.RewardRecord, buffer)
+	rawSub, err := cc.client.SubscribeRewards(ctx.context, ch)
+	if err != nil {
+		return nil, err
+	}
+	// Start up a dispatcher to feed into the callback
+	go func() {
+		for {
+			select {
+			case record := <-ch:
+				handler.OnReward(&CongressReward{record})
+
+			case err := <-rawSub.Err():
+				if err != nil {
+					handler.OnError(err.Error())
+				}
+				return
+			}
+		}
+	}()
+	return &Subscription{rawSub}, nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package p2p
+
+import (
+	"net"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/netutil"
+)
+
+// NodeAllowlist is a set of nodes allowed to peer, by node ID or by IP network.
+// An empty allowlist allows every node, unless it is closed.
+type NodeAllowlist struct {
+	ids    map[enode.ID]struct{}
+	nets   netutil.Netlist
+	closed bool // Whether no node is allowed, apart from the trusted ones
+}
+
+// NewNodeAllowlist creates an allowlist of the given node IDs and IP networks.
+func NewNodeAllowlist(ids []enode.ID, nets netutil.Netlist) *NodeAllowlist {
+	l := &NodeAllowlist{ids: make(map[enode.ID]struct{}, len(ids)), nets: nets}
+	for _, id := range ids {
+		l.ids[id] = struct{}{}
+	}
+	return l
+}
+
+// NewClosedNodeAllowlist creates an allowlist allowing no node, so that only the
+// trusted peers are kept.
+func NewClosedNodeAllowlist() *NodeAllowlist {
+	return &NodeAllowlist{closed: true}
+}
+
+// Len returns the number of node IDs and IP networks of the allowlist.
+func (l *NodeAllowlist) Len() int {
+	return len(l.ids) + len(l.nets)
+}
+
+// Allows checks whether the node with the given ID and IP is allowed to peer.
+func (l *NodeAllowlist) Allows(id enode.ID, ip net.IP) bool {
+	if l == nil {
+		return true
+	}
+	if l.closed {
+		return false
+	}
+	if l.Len() == 0 {
+		return true
+	}
+	if _, ok := l.ids[id]; ok {
+		return true
+	}
+	return ip != nil && l.nets.Contains(ip)
+}
+
+// Equal checks whether both allowlists contain the same node IDs and IP networks.
+func (l *NodeAllowlist) Equal(other *NodeAllowlist) bool {
+	if l == nil || other == nil {
+		return l == other
+	}
+	if l.closed != other.closed || len(l.ids) != len(other.ids) || len(l.nets) != len(other.nets) {
+		return false
+	}
+	for id := range l.ids {
+		if _, ok := other.ids[id]; !ok {
+			return false
+		}
+	}
+	for i := range l.nets {
+		if l.nets[i].String() != other.nets[i].String() {
+			return false
+		}
+	}
+	return true
+}
+
+// SetNodeAllowlist sets the nodes allowed to peer, nil to allow every node, and
+// disconnects the peers not allowed anymore. Trusted peers are always allowed.
+func (srv *Server) SetNodeAllowlist(list *NodeAllowlist) {
+	srv.allowlistLock.Lock()
+	srv.allowlist = list
+	srv.allowlistLock.Unlock()
+
+	srv.lock.Lock()
+	running := srv.running
+	srv.lock.Unlock()
+	if !running {
+		return
+	}
+	srv.doPeerOp(func(peers map[enode.ID]*Peer) {
+		for _, p := range peers {
+			if !p.rw.is(trustedConn) && !list.Allows(p.ID(), p.rw.node.IP()) {
+				p.log.Debug("Disconnecting peer not in allowlist")
+				p.Disconnect(DiscNotAllowed)
+			}
+		}
+	})
+}
+
+// allowed checks whether the connection is allowed by the node allowlist.
+func (srv *Server) allowed(c *conn) bool {
+	srv.allowlistLock.RLock()
+	defer srv.allowlistLock.RUnlock()
+
+	return c.is(trustedConn) || srv.allowlist.Allows(c.node.ID(), c.node.IP())
+}
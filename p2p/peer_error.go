@@ -69,6 +69,7 @@ const (
 	DiscUnexpectedIdentity
 	DiscSelf
 	DiscReadTimeout
+	DiscNotAllowed
 	DiscSubprotocolError = DiscReason(0x10)
 )
 
@@ -85,6 +86,7 @@ var discReasonToString = [...]string{
 	DiscUnexpectedIdentity:  "unexpected identity",
 	DiscSelf:                "connected to self",
 	DiscReadTimeout:         "read timeout",
+	DiscNotAllowed:          "node not in allowlist",
 	DiscSubprotocolError:    "subprotocol error",
 }
 
@@ -39,6 +39,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/ethereum/go-ethereum/p2p/nat"
 	"github.com/ethereum/go-ethereum/p2p/netutil"
+	lru "github.com/hashicorp/golang-lru"
 )
 
 const (
@@ -49,6 +50,10 @@ const (
 	// sources.
 	discmixTimeout = 5 * time.Second
 
+	// Limits of the lookups of the records of the nodes found by discovery v4.
+	recordCacheSize       = 1024
+	recordRequestInterval = 100 * time.Millisecond
+
 	// Connectivity defaults.
 	defaultMaxPendingPeers = 50
 	defaultDialRatio       = 3
@@ -197,6 +202,9 @@ type Server struct {
 
 	// State of run loop and listenLoop.
 	inboundHistory expHeap
+
+	allowlist     *NodeAllowlist // Nodes allowed to peer, nil if all of them are
+	allowlistLock sync.RWMutex
 }
 
 type peerOpFunc func(map[enode.ID]*Peer)
@@ -614,6 +622,98 @@ func (srv *Server) setupDiscovery() error {
 	return nil
 }
 
+// DiscoveryNodes returns an iterator of the nodes found by the discovery protocols,
+// with their latest node records, or nil if discovery is disabled.
+func (srv *Server) DiscoveryNodes() enode.Iterator {
+	var its []enode.Iterator
+	if srv.ntab != nil {
+		// Discovery v4 only learns the endpoints of the nodes, ask for their records
+		its = append(its, newRecordIterator(srv.ntab.RandomNodes(), srv.ntab.RequestENR, recordRequestInterval))
+	}
+	if srv.DiscV5 != nil {
+		its = append(its, srv.DiscV5.RandomNodes())
+	}
+	switch len(its) {
+	case 0:
+		return nil
+	case 1:
+		return its[0]
+	}
+	mix := enode.NewFairMix(discmixTimeout)
+	for _, it := range its {
+		mix.AddSource(it)
+	}
+	return mix
+}
+
+// recordIterator resolves the records of the nodes of an iterator. The resolved
+// records are cached, and only requested again once the node announces a newer
+// one. The requests are spaced by at least the given interval.
+type recordIterator struct {
+	enode.Iterator
+	request  func(*enode.Node) (*enode.Node, error)
+	records  *lru.Cache // Resolved records by node ID
+	interval time.Duration
+	last     time.Time // Time of the last request
+
+	closed    chan struct{}
+	closeOnce sync.Once
+	node      *enode.Node
+}
+
+func newRecordIterator(it enode.Iterator, request func(*enode.Node) (*enode.Node, error), interval time.Duration) *recordIterator {
+	records, _ := lru.New(recordCacheSize)
+	return &recordIterator{
+		Iterator: it,
+		request:  request,
+		records:  records,
+		interval: interval,
+		closed:   make(chan struct{}),
+	}
+}
+
+// Next moves to the next node and resolves its record.
+func (it *recordIterator) Next() bool {
+	it.node = nil
+	if !it.Iterator.Next() {
+		return false
+	}
+	n := it.Iterator.Node()
+	if cached, ok := it.records.Get(n.ID()); ok && cached.(*enode.Node).Seq() >= n.Seq() {
+		it.node = cached.(*enode.Node)
+		return true
+	}
+	if wait := it.interval - time.Since(it.last); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-it.closed:
+			return false
+		}
+	}
+	it.last = time.Now()
+
+	it.node = n
+	if rn, err := it.request(n); err == nil {
+		it.records.Add(rn.ID(), rn)
+		it.node = rn
+	}
+	return true
+}
+
+// Node returns the current node with its resolved record.
+func (it *recordIterator) Node() *enode.Node {
+	return it.node
+}
+
+// Close ends the iteration, interrupting a pending wait for the next request.
+func (it *recordIterator) Close() {
+	it.closeOnce.Do(func() { close(it.closed) })
+	it.Iterator.Close()
+}
+
 func (srv *Server) setupDialScheduler() {
 	config := dialConfig{
 		self:           srv.localnode.ID(),
@@ -810,6 +910,8 @@ func (srv *Server) postHandshakeChecks(peers map[enode.ID]*Peer, inboundCount in
 		return DiscAlreadyConnected
 	case c.node.ID() == srv.localnode.ID():
 		return DiscSelf
+	case !srv.allowed(c):
+		return DiscNotAllowed
 	default:
 		return nil
 	}
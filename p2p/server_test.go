@@ -32,6 +32,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/ethereum/go-ethereum/p2p/netutil"
 	"github.com/ethereum/go-ethereum/p2p/rlpx"
 )
 
@@ -300,6 +301,69 @@ func TestServerAtCap(t *testing.T) {
 	}
 }
 
+func TestServerNodeAllowlist(t *testing.T) {
+	remote := newkey()
+	srv := &Server{
+		Config: Config{
+			PrivateKey:  newkey(),
+			MaxPeers:    10,
+			NoDial:      true,
+			NoDiscovery: true,
+			Logger:      testlog.Logger(t, log.LvlTrace),
+		},
+	}
+	if err := srv.Start(); err != nil {
+		t.Fatalf("could not start: %v", err)
+	}
+	defer srv.Stop()
+
+	newconn := func(id enode.ID, addr string) *conn {
+		fd, _ := net.Pipe()
+		tx := newTestTransport(&remote.PublicKey, fd, nil)
+		return &conn{fd: fd, transport: tx, flags: inboundConn, node: newNode(id, addr), cont: make(chan error)}
+	}
+	var (
+		allowedID = randomID()
+		netID     = randomID()
+		otherID   = randomID()
+		nets, _   = netutil.ParseNetlist("10.0.0.0/8")
+	)
+	// Every node is allowed until the allowlist gets entries
+	if err := srv.checkpoint(newconn(otherID, "192.168.0.1:30303"), srv.checkpointAddPeer); err != nil {
+		t.Fatalf("conn rejected without allowlist: %v", err)
+	}
+	srv.SetNodeAllowlist(NewNodeAllowlist([]enode.ID{allowedID}, *nets))
+
+	if err := srv.checkpoint(newconn(allowedID, "192.168.0.2:30303"), srv.checkpointPostHandshake); err != nil {
+		t.Errorf("allowed node rejected: %v", err)
+	}
+	if err := srv.checkpoint(newconn(netID, "10.1.2.3:30303"), srv.checkpointPostHandshake); err != nil {
+		t.Errorf("node of allowed network rejected: %v", err)
+	}
+	if err := srv.checkpoint(newconn(randomID(), "192.168.0.3:30303"), srv.checkpointPostHandshake); err != DiscNotAllowed {
+		t.Errorf("wrong error for node not in allowlist: %v", err)
+	}
+	// The peer connected before the allowlist is dropped
+	for start := time.Now(); srv.PeerCount() != 0; time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > time.Second {
+			t.Fatalf("peer not in allowlist still connected")
+		}
+	}
+	// Trusted nodes are always allowed
+	srv.AddTrustedPeer(newNode(otherID, ""))
+	if err := srv.checkpoint(newconn(otherID, "192.168.0.1:30303"), srv.checkpointPostHandshake); err != nil {
+		t.Errorf("trusted node rejected: %v", err)
+	}
+	// A closed allowlist only keeps the trusted nodes
+	srv.SetNodeAllowlist(NewClosedNodeAllowlist())
+	if err := srv.checkpoint(newconn(allowedID, "192.168.0.2:30303"), srv.checkpointPostHandshake); err != DiscNotAllowed {
+		t.Errorf("wrong error for node with closed allowlist: %v", err)
+	}
+	if err := srv.checkpoint(newconn(otherID, "192.168.0.1:30303"), srv.checkpointPostHandshake); err != nil {
+		t.Errorf("trusted node rejected with closed allowlist: %v", err)
+	}
+}
+
 func TestServerPeerLimits(t *testing.T) {
 	srvkey := newkey()
 	clientkey := newkey()
@@ -621,3 +685,55 @@ func syncAddPeer(srv *Server, node *enode.Node) bool {
 		}
 	}
 }
+
+func TestRecordIterator(t *testing.T) {
+	node := func(id enode.ID, seq uint64) *enode.Node {
+		var r enr.Record
+		r.SetSeq(seq)
+		return enode.SignNull(&r, id)
+	}
+	var (
+		a, b     = enode.ID{1}, enode.ID{2}
+		requests []enode.ID
+		request  = func(n *enode.Node) (*enode.Node, error) {
+			requests = append(requests, n.ID())
+			if n.ID() == b {
+				return nil, errors.New("timeout")
+			}
+			return node(n.ID(), n.Seq()+2), nil
+		}
+		interval = 20 * time.Millisecond
+		start    = time.Now()
+	)
+	it := newRecordIterator(enode.IterNodes([]*enode.Node{node(a, 0), node(b, 0), node(a, 0), node(a, 3)}), request, interval)
+	var seqs []uint64
+	for it.Next() {
+		seqs = append(seqs, it.Node().Seq())
+	}
+	// The known record of a is reused until a newer one is announced, and the
+	// failed lookup of b leaves its node as found
+	if want := []enode.ID{a, b, a}; !reflect.DeepEqual(requests, want) {
+		t.Errorf("requests mismatch: have %v, want %v", requests, want)
+	}
+	if want := []uint64{2, 0, 2, 5}; !reflect.DeepEqual(seqs, want) {
+		t.Errorf("record sequences mismatch: have %v, want %v", seqs, want)
+	}
+	if elapsed := time.Since(start); elapsed < 2*interval {
+		t.Errorf("requests not rate limited: %d requests in %v", len(requests), elapsed)
+	}
+
+	// Closing the iterator interrupts the wait for the next request
+	it = newRecordIterator(enode.CycleNodes([]*enode.Node{node(a, 0), node(b, 0)}), request, time.Hour)
+	it.Next()
+	done := make(chan bool)
+	go func() { done <- it.Next() }()
+	it.Close()
+	select {
+	case ok := <-done:
+		if ok {
+			t.Fatal("iterator moved on after closing")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("closing didn't interrupt the rate limit")
+	}
+}
@@ -250,6 +250,8 @@ type CongressConfig struct {
 	Epoch  uint64 `json:"epoch"`  // Epoch length to reset votes and checkpoint
 
 	EnableDevVerification bool `json:"enableDevVerification"` // Enable developer address verification
+
+	GovernanceBlock *big.Int `json:"governanceBlock,omitempty"` // Governed consensus parameters, native system stores and log data event check rules switch block (nil = no fork, 0 = already activated)
 }
 
 // String implements the stringer interface, returning the consensus engine details.
@@ -371,6 +373,14 @@ func (c *ChainConfig) IsTerminalPoWBlock(parentTotalDiff *big.Int, totalDiff *bi
 // 	return isForked(c.RedCoastBlock, num)
 // }
 
+// IsGovernance returns whether num represents a block number after the congress
+// governance fork, from which on the consensus parameters and the native system
+// stores are adjustable by system governance proposals, and event check rules
+// may check addresses of log data.
+func (c *CongressConfig) IsGovernance(num *big.Int) bool {
+	return isForked(c.GovernanceBlock, num)
+}
+
 // IsSophon returns whether num represents a block number after the SophonBlock fork
 // func (c *ChainConfig) IsSophon(num *big.Int) bool {
 // 	return isForked(c.SophonBlock, num)
@@ -522,6 +532,9 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *Confi
 	if isForkIncompatible(c.ArrowGlacierBlock, newcfg.ArrowGlacierBlock, head) {
 		return newCompatError("Arrow Glacier fork block", c.ArrowGlacierBlock, newcfg.ArrowGlacierBlock)
 	}
+	if c.Congress != nil && newcfg.Congress != nil && isForkIncompatible(c.Congress.GovernanceBlock, newcfg.Congress.GovernanceBlock, head) {
+		return newCompatError("Congress governance fork block", c.Congress.GovernanceBlock, newcfg.Congress.GovernanceBlock)
+	}
 	return nil
 }
 
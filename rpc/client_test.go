@@ -311,6 +311,37 @@ func TestClientSubscribe(t *testing.T) {
 	}
 }
 
+// This test checks that event subscriptions are forwarded and ended along with
+// the client subscription.
+func TestClientSubscribeEvents(t *testing.T) {
+	var (
+		service = &notificationTestService{unsubscribed: make(chan string, 1)}
+		server  = NewServer()
+	)
+	defer server.Stop()
+	server.RegisterName("nftest", service)
+	client := DialInProc(server)
+	defer client.Close()
+
+	nc := make(chan int)
+	count := 5
+	sub, err := client.Subscribe(context.Background(), "nftest", nc, "eventSubscription", count)
+	if err != nil {
+		t.Fatal("can't subscribe:", err)
+	}
+	for i := 0; i < count; i++ {
+		if val := <-nc; val != 2*i {
+			t.Fatalf("value mismatch: got %d, want %d", val, 2*i)
+		}
+	}
+	sub.Unsubscribe()
+	select {
+	case <-service.unsubscribed:
+	case <-time.After(1 * time.Second):
+		t.Fatal("event subscription not ended within 1s after unsubscribe")
+	}
+}
+
 // In this test, the connection drops while Subscribe is waiting for a response.
 func TestClientSubscribeClose(t *testing.T) {
 	server := newTestServer()
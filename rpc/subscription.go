@@ -88,6 +88,47 @@ func NotifierFromContext(ctx context.Context) (*Notifier, bool) {
 	return n, ok
 }
 
+// EventSubscription is a subscription delivering events on a channel, such as
+// the subscriptions of an event.Feed.
+type EventSubscription interface {
+	Err() <-chan error
+	Unsubscribe()
+}
+
+// NotifyEvents creates a subscription notifying every event received on ch, the
+// channel sub delivers its events on. The events are converted by convert if it's
+// not nil. sub is unsubscribed when the created subscription ends.
+func NotifyEvents(ctx context.Context, sub EventSubscription, ch interface{}, convert func(interface{}) interface{}) (*Subscription, error) {
+	notifier, supported := NotifierFromContext(ctx)
+	if !supported {
+		sub.Unsubscribe()
+		return &Subscription{}, ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		defer sub.Unsubscribe()
+
+		cases := []reflect.SelectCase{
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)},
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(rpcSub.Err())},
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(notifier.Closed())},
+		}
+		for {
+			chosen, ev, ok := reflect.Select(cases)
+			if chosen != 0 || !ok {
+				return
+			}
+			event := ev.Interface()
+			if convert != nil {
+				event = convert(event)
+			}
+			notifier.Notify(rpcSub.ID, event)
+		}
+	}()
+	return rpcSub, nil
+}
+
 // Notifier is tied to a RPC connection that supports subscriptions.
 // Server callbacks use the notifier to send notifications.
 type Notifier struct {
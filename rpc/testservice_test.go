@@ -180,6 +180,39 @@ func (s *notificationTestService) SomeSubscription(ctx context.Context, n, val i
 	return subscription, nil
 }
 
+// EventSubscription forwards n events of an event subscription, doubled.
+func (s *notificationTestService) EventSubscription(ctx context.Context, n int) (*Subscription, error) {
+	var (
+		events = make(chan int)
+		sub    = &testEventSubscription{err: make(chan error), unsubscribed: s.unsubscribed}
+	)
+	go func() {
+		for i := 0; i < n; i++ {
+			select {
+			case events <- i:
+			case <-sub.err:
+				return
+			}
+		}
+	}()
+	return NotifyEvents(ctx, sub, events, func(ev interface{}) interface{} { return 2 * ev.(int) })
+}
+
+// testEventSubscription is an event subscription reporting its end.
+type testEventSubscription struct {
+	err          chan error
+	unsubscribed chan string
+}
+
+func (s *testEventSubscription) Err() <-chan error { return s.err }
+
+func (s *testEventSubscription) Unsubscribe() {
+	close(s.err)
+	if s.unsubscribed != nil {
+		s.unsubscribed <- "event"
+	}
+}
+
 // HangSubscription blocks on s.unblockHangSubscription before sending anything.
 func (s *notificationTestService) HangSubscription(ctx context.Context, val int) (*Subscription, error) {
 	notifier, supported := NotifierFromContext(ctx)